@@ -43,6 +43,8 @@ type MultiNode[
 	chainFamily           string
 	reportInterval        time.Duration
 	deathDeclarationDelay time.Duration
+	hedgedReads           HedgedReadsConfig
+	readLatencies         readLatencies
 
 	activeMu   sync.RWMutex
 	activeNode Node[CHAIN_ID, RPC]
@@ -51,6 +53,9 @@ type MultiNode[
 	wg     sync.WaitGroup
 }
 
+// defaultLatencyWeightedLeaseDuration is the lease used by NodeSelectionModeLatencyWeighted, if none is configured.
+const defaultLatencyWeightedLeaseDuration = 5 * time.Minute
+
 func NewMultiNode[
 	CHAIN_ID types.ID,
 	RPC any,
@@ -63,8 +68,13 @@ func NewMultiNode[
 	chainID CHAIN_ID, // configured chain ID (used to verify that passed primaryNodes belong to the same chain)
 	chainFamily string, // name of the chain family - used in the metrics
 	deathDeclarationDelay time.Duration,
+	hedgedReads HedgedReadsConfig, // defines when reads executed via DoHedgedRead are duplicated to a second RPC
 ) *MultiNode[CHAIN_ID, RPC] {
 	nodeSelector := newNodeSelector(selectionMode, primaryNodes)
+	if selectionMode == NodeSelectionModeLatencyWeighted && leaseDuration <= 0 {
+		// scores change as nodes are polled, but the best node is only re-selected when its lease expires
+		leaseDuration = defaultLatencyWeightedLeaseDuration
+	}
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
@@ -80,6 +90,7 @@ func NewMultiNode[
 		chainFamily:           chainFamily,
 		reportInterval:        reportInterval,
 		deathDeclarationDelay: deathDeclarationDelay,
+		hedgedReads:           hedgedReads,
 	}
 
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
@@ -144,6 +155,19 @@ func (c *MultiNode[CHAIN_ID, RPC]) NodeStates() map[string]string {
 	return states
 }
 
+// NodeScores returns a map of primary node Name->node score. Scores are only reported if the selection mode uses them
+// to choose the best node, otherwise nil is returned.
+func (c *MultiNode[CHAIN_ID, RPC]) NodeScores() map[string]float64 {
+	if c.selectionMode != NodeSelectionModeLatencyWeighted {
+		return nil
+	}
+	scores := map[string]float64{}
+	for _, n := range c.primaryNodes {
+		scores[n.Name()] = n.Score()
+	}
+	return scores
+}
+
 // Start starts every node in the pool
 //
 // Nodes handle their own redialing and runloops, so this function does not
@@ -187,6 +211,16 @@ func (c *MultiNode[CHAIN_ID, RPC]) Start(ctx context.Context) error {
 	})
 }
 
+func (c *MultiNode[CHAIN_ID, RPC]) Name() string {
+	return c.lggr.Name()
+}
+
+// HealthReport - reports MultiNode as unhealthy if there are no live nodes, or if RPCs disagreed on results of quorum reads
+// since the previous report.
+func (c *MultiNode[CHAIN_ID, RPC]) HealthReport() map[string]error {
+	return map[string]error{c.Name(): c.Healthy()}
+}
+
 // Close tears down the MultiNode and closes all nodes
 func (c *MultiNode[CHAIN_ID, RPC]) Close() error {
 	return c.StopOnce("MultiNode", func() error {
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var (
+	promMultiNodeHedgedReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads",
+		Help: "The total number of read requests that were sent to a second RPC, as the selected RPC did not respond within the hedging deadline",
+	}, []string{"network", "chainId"})
+	promMultiNodeHedgedReadsWon = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads_won",
+		Help: "The total number of hedged read requests where the second RPC responded first",
+	}, []string{"network", "chainId"})
+)
+
+// readLatenciesWindowSize - number of the most recent successful reads used to calculate hedging deadline
+const readLatenciesWindowSize = 100
+
+// HedgedReadsConfig - defines when a read request should be duplicated to a second RPC.
+type HedgedReadsConfig struct {
+	// Enabled - if false, all reads are sent to the selected RPC only
+	Enabled bool
+	// Percentile - percentile of the recently observed read latencies used as a hedging deadline
+	Percentile uint16
+	// MinDelay - lower bound of the hedging deadline. Used as deadline until any latencies are observed
+	MinDelay time.Duration
+}
+
+// readLatencies keeps a moving window of the most recent successful read latencies.
+// Zero value is ready to use.
+type readLatencies struct {
+	mu      sync.RWMutex
+	samples [readLatenciesWindowSize]time.Duration
+	next    int
+	count   int
+}
+
+func (r *readLatencies) record(latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples[r.next] = latency
+	r.next = (r.next + 1) % readLatenciesWindowSize
+	if r.count < readLatenciesWindowSize {
+		r.count++
+	}
+}
+
+// percentile - returns latency below which the given percent of the observed latencies fall.
+// Returns 0 if there are no observations.
+func (r *readLatencies) percentile(p uint16) time.Duration {
+	r.mu.RLock()
+	sorted := slices.Clone(r.samples[:r.count])
+	r.mu.RUnlock()
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	slices.Sort(sorted)
+	idx := int(math.Ceil(float64(p)/100*float64(len(sorted)))) - 1
+	idx = max(0, min(idx, len(sorted)-1))
+	return sorted[idx]
+}
+
+// HedgedReadsEnabled - returns true, if reads executed via DoHedgedRead might be sent to a second RPC.
+func (c *MultiNode[CHAIN_ID, RPC]) HedgedReadsEnabled() bool {
+	return c.hedgedReads.Enabled
+}
+
+// hedgingDeadline - returns duration after which the read should be sent to a second RPC
+func (c *MultiNode[CHAIN_ID, RPC]) hedgingDeadline() time.Duration {
+	return max(c.hedgedReads.MinDelay, c.readLatencies.percentile(c.hedgedReads.Percentile))
+}
+
+// selectHedgingNode - returns alive node with the highest priority, other than the primary one.
+// Returns nil if there are no such nodes.
+func (c *MultiNode[CHAIN_ID, RPC]) selectHedgingNode(primary Node[CHAIN_ID, RPC]) Node[CHAIN_ID, RPC] {
+	var candidates []Node[CHAIN_ID, RPC]
+	for _, n := range c.primaryNodes {
+		if n != primary && n.State() == nodeStateAlive {
+			candidates = append(candidates, n)
+		}
+	}
+	return firstOrHighestPriority(candidates)
+}
+
+// DoHedgedRead executes read on the selected RPC. If hedged reads are enabled and the RPC has not responded within
+// the hedging deadline, the same read is sent to another alive RPC. The first successful response wins and the other
+// request is cancelled. If both requests fail, the error of the last response is returned.
+// read must not have any side effects, as it might be executed concurrently against different RPCs.
+func DoHedgedRead[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+](ctx context.Context, c *MultiNode[CHAIN_ID, RPC], read func(ctx context.Context, rpc RPC) (RESULT, error)) (result RESULT, err error) {
+	primary, err := c.selectNode()
+	if err != nil {
+		return result, err
+	}
+
+	if !c.hedgedReads.Enabled {
+		return read(ctx, primary.RPC())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type response struct {
+		result   RESULT
+		err      error
+		isHedged bool
+	}
+	// buffered to ensure that a request that lost the race does not block
+	responses := make(chan response, 2)
+	doRead := func(n Node[CHAIN_ID, RPC], isHedged bool) {
+		start := time.Now()
+		r, rErr := read(ctx, n.RPC())
+		if rErr == nil {
+			c.readLatencies.record(time.Since(start))
+		}
+		responses <- response{result: r, err: rErr, isHedged: isHedged}
+	}
+
+	go doRead(primary, false)
+	pending := 1
+
+	hedgingTimer := time.NewTimer(c.hedgingDeadline())
+	defer hedgingTimer.Stop()
+	hedgingCh := hedgingTimer.C
+	for {
+		select {
+		case <-hedgingCh:
+			// never hedge more than once
+			hedgingCh = nil
+			secondary := c.selectHedgingNode(primary)
+			if secondary == nil {
+				continue
+			}
+			promMultiNodeHedgedReads.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+			c.lggr.Debugw("Selected RPC did not respond within hedging deadline, sending read to another RPC",
+				"primary", primary.String(), "secondary", secondary.String())
+			pending++
+			go doRead(secondary, true)
+		case resp := <-responses:
+			pending--
+			if resp.err == nil {
+				if resp.isHedged {
+					promMultiNodeHedgedReadsWon.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+				}
+				return resp.result, nil
+			}
+			// if hedged request is still in flight, give it a chance to succeed
+			if pending == 0 {
+				return resp.result, resp.err
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type hedgedReadRPC string
+
+func newHedgedReadMultiNode(t *testing.T, hedgedReads HedgedReadsConfig) (*MultiNode[types.ID, hedgedReadRPC], *mockNode[types.ID, hedgedReadRPC], *mockNode[types.ID, hedgedReadRPC]) {
+	newNode := func(rpc hedgedReadRPC, order int32) *mockNode[types.ID, hedgedReadRPC] {
+		node := newMockNode[types.ID, hedgedReadRPC](t)
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("Order").Return(order).Maybe()
+		node.On("String").Return(string(rpc)).Maybe()
+		return node
+	}
+	primary := newNode("primary", 1)
+	secondary := newNode("secondary", 2)
+	mn := NewMultiNode[types.ID, hedgedReadRPC](logger.Test(t), NodeSelectionModePriorityLevel, 0,
+		[]Node[types.ID, hedgedReadRPC]{primary, secondary}, nil, types.RandomID(), "chainFamily", 0, hedgedReads)
+	mn.activeNode = primary
+	return mn, primary, secondary
+}
+
+func TestDoHedgedRead(t *testing.T) {
+	t.Parallel()
+
+	hedgedReads := HedgedReadsConfig{Enabled: true, Percentile: 95, MinDelay: tests.TestInterval}
+	// slowRead - blocks reads from the primary RPC until the ctx is done
+	slowRead := func(ctx context.Context, rpc hedgedReadRPC) (string, error) {
+		if rpc == "primary" {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}
+		return string(rpc), nil
+	}
+
+	t.Run("Does not hedge if disabled", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, HedgedReadsConfig{})
+		ctx, cancel := context.WithTimeout(tests.Context(t), 2*tests.TestInterval)
+		defer cancel()
+		_, err := DoHedgedRead(ctx, mn, slowRead)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("Returns response of the primary RPC, if it's fast enough", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, hedgedReads)
+		result, err := DoHedgedRead(tests.Context(t), mn, func(ctx context.Context, rpc hedgedReadRPC) (string, error) {
+			return string(rpc), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+	})
+	t.Run("Returns error of the primary RPC, if it fails before deadline", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, HedgedReadsConfig{Enabled: true, MinDelay: time.Hour})
+		expectedErr := errors.New("primary failed")
+		_, err := DoHedgedRead(tests.Context(t), mn, func(ctx context.Context, rpc hedgedReadRPC) (string, error) {
+			if rpc == "primary" {
+				return "", expectedErr
+			}
+			return string(rpc), nil
+		})
+		require.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("Sends read to the secondary RPC, if primary is slow", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, hedgedReads)
+		result, err := DoHedgedRead(tests.Context(t), mn, slowRead)
+		require.NoError(t, err)
+		assert.Equal(t, "secondary", result)
+	})
+	t.Run("Waits for the primary RPC, if secondary failed", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, hedgedReads)
+		result, err := DoHedgedRead(tests.Context(t), mn, func(ctx context.Context, rpc hedgedReadRPC) (string, error) {
+			if rpc == "secondary" {
+				return "", errors.New("secondary failed")
+			}
+			time.Sleep(2 * tests.TestInterval)
+			return string(rpc), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+	})
+	t.Run("Returns error if both RPCs failed", func(t *testing.T) {
+		t.Parallel()
+		mn, _, _ := newHedgedReadMultiNode(t, hedgedReads)
+		expectedErr := errors.New("primary failed")
+		_, err := DoHedgedRead(tests.Context(t), mn, func(ctx context.Context, rpc hedgedReadRPC) (string, error) {
+			if rpc == "secondary" {
+				return "", errors.New("secondary failed")
+			}
+			time.Sleep(2 * tests.TestInterval)
+			return "", expectedErr
+		})
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestReadLatencies_Percentile(t *testing.T) {
+	t.Parallel()
+
+	var latencies readLatencies
+	assert.Equal(t, time.Duration(0), latencies.percentile(95))
+	for i := 1; i <= readLatenciesWindowSize; i++ {
+		latencies.record(time.Duration(i) * time.Millisecond)
+	}
+	assert.Equal(t, 95*time.Millisecond, latencies.percentile(95))
+	assert.Equal(t, 100*time.Millisecond, latencies.percentile(100))
+	assert.Equal(t, time.Millisecond, latencies.percentile(0))
+
+	// old observations are evicted from the window
+	for i := 0; i < readLatenciesWindowSize; i++ {
+		latencies.record(time.Second)
+	}
+	assert.Equal(t, time.Second, latencies.percentile(50))
+}
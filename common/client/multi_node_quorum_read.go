@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var (
+	promMultiNodeQuorumReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_quorum_reads",
+		Help: "The total number of read requests that required agreement of a quorum of RPCs",
+	}, []string{"network", "chainId"})
+	promMultiNodeQuorumReadsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_quorum_reads_failed",
+		Help: "The total number of quorum read requests that failed to reach the quorum",
+	}, []string{"network", "chainId"})
+	promMultiNodeQuorumReadDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_quorum_read_disagreements",
+		Help: "The total number of quorum read requests where the given RPC returned result that differs from the majority",
+	}, []string{"network", "chainId", "nodeName"})
+)
+
+// ErrQuorumNotReached - returned by DoQuorumRead, if there are not enough RPCs that agree on the result
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+// DoQuorumRead executes read on all alive primary RPCs in parallel. The result is only accepted, if at least quorum
+// of RPCs returned the same result, and more RPCs returned it than any other result, where results are compared using key. Quorum of 1 or lower disables the check and
+// the read is executed on the selected RPC.
+// RPCs that returned a result different from the majority are logged, counted in metrics and reported as unhealthy.
+// If results are tied, the read fails without reporting any RPC.
+// Failed requests do not count towards the quorum, but are not considered disagreements.
+func DoQuorumRead[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+](ctx context.Context, c *MultiNode[CHAIN_ID, RPC], quorum int, read func(ctx context.Context, rpc RPC) (RESULT, error), key func(RESULT) string) (result RESULT, err error) {
+	if quorum <= 1 {
+		var rpc RPC
+		rpc, err = c.SelectRPC()
+		if err != nil {
+			return result, err
+		}
+		return read(ctx, rpc)
+	}
+
+	promMultiNodeQuorumReads.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+	var nodes []Node[CHAIN_ID, RPC]
+	for _, n := range c.primaryNodes {
+		if n.State() == nodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	if len(nodes) < quorum {
+		promMultiNodeQuorumReadsFailed.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+		return result, fmt.Errorf("%w: %d RPCs are required to agree, but only %d are alive", ErrQuorumNotReached, quorum, len(nodes))
+	}
+
+	type response struct {
+		node   Node[CHAIN_ID, RPC]
+		result RESULT
+		err    error
+	}
+	responses := make([]response, len(nodes))
+	var wg sync.WaitGroup
+	wg.Add(len(nodes))
+	for i, n := range nodes {
+		go func(i int, n Node[CHAIN_ID, RPC]) {
+			defer wg.Done()
+			r, rErr := read(ctx, n.RPC())
+			responses[i] = response{node: n, result: r, err: rErr}
+		}(i, n)
+	}
+	wg.Wait()
+
+	votes := map[string]int{}
+	var majorityKey string
+	var majorityVotes int
+	var tied bool
+	var errs []error
+	for _, resp := range responses {
+		if resp.err != nil {
+			errs = append(errs, fmt.Errorf("RPC %s: %w", resp.node.String(), resp.err))
+			continue
+		}
+		k := key(resp.result)
+		votes[k]++
+		switch {
+		case votes[k] > majorityVotes:
+			majorityKey, majorityVotes, result, tied = k, votes[k], resp.result, false
+		case votes[k] == majorityVotes && k != majorityKey:
+			tied = true
+		}
+	}
+
+	if tied {
+		// no result has more votes than all the others, so there is neither a majority nor RPCs that differ from it
+		promMultiNodeQuorumReadsFailed.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+		var zero RESULT
+		err = fmt.Errorf("%w: %d RPCs are required to agree, but the results are tied with %d votes each", ErrQuorumNotReached, quorum, majorityVotes)
+		if len(errs) > 0 {
+			err = fmt.Errorf("%w: %w", err, errors.Join(errs...))
+		}
+		return zero, err
+	}
+
+	for _, resp := range responses {
+		if resp.err != nil || key(resp.result) == majorityKey {
+			continue
+		}
+		promMultiNodeQuorumReadDisagreements.WithLabelValues(c.chainFamily, c.chainID.String(), resp.node.Name()).Inc()
+		c.lggr.Warnw("RPC returned result that differs from the majority", "rpc", resp.node.String(),
+			"result", key(resp.result), "majorityResult", majorityKey, "majorityVotes", majorityVotes)
+		c.SvcErrBuffer.Append(fmt.Errorf("RPC %s returned result that differs from the majority of RPCs", resp.node.String()))
+	}
+
+	if majorityVotes < quorum {
+		promMultiNodeQuorumReadsFailed.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+		var zero RESULT
+		err = fmt.Errorf("%w: %d RPCs are required to agree, but only %d did", ErrQuorumNotReached, quorum, majorityVotes)
+		if len(errs) > 0 {
+			err = fmt.Errorf("%w: %w", err, errors.Join(errs...))
+		}
+		return zero, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type quorumReadRPC string
+
+func newQuorumReadMultiNode(t *testing.T, rpcs ...quorumReadRPC) *MultiNode[types.ID, quorumReadRPC] {
+	var nodes []Node[types.ID, quorumReadRPC]
+	for i, rpc := range rpcs {
+		node := newMockNode[types.ID, quorumReadRPC](t)
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("Order").Return(int32(i)).Maybe()
+		node.On("String").Return(string(rpc)).Maybe()
+		node.On("Name").Return(string(rpc)).Maybe()
+		nodes = append(nodes, node)
+	}
+	mn := NewMultiNode[types.ID, quorumReadRPC](logger.Test(t), NodeSelectionModePriorityLevel, 0,
+		nodes, nil, types.RandomID(), "chainFamily", 0, HedgedReadsConfig{})
+	mn.activeNode = nodes[0]
+	return mn
+}
+
+func TestDoQuorumRead(t *testing.T) {
+	t.Parallel()
+
+	key := func(r string) string { return r }
+	t.Run("Reads from the active RPC, if quorum is disabled", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b")
+		result, err := DoQuorumRead(tests.Context(t), mn, 1, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			return string(rpc), nil
+		}, key)
+		require.NoError(t, err)
+		assert.Equal(t, "a", result)
+	})
+	t.Run("Fails, if there are not enough alive RPCs", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b")
+		_, err := DoQuorumRead(tests.Context(t), mn, 3, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			return string(rpc), nil
+		}, key)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+	})
+	t.Run("Returns result, if quorum agrees", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b", "c")
+		result, err := DoQuorumRead(tests.Context(t), mn, 2, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			if rpc == "c" {
+				return "bad", nil
+			}
+			return "good", nil
+		}, key)
+		require.NoError(t, err)
+		assert.Equal(t, "good", result)
+		// disagreeing RPC is reported as unhealthy
+		require.ErrorContains(t, mn.SvcErrBuffer.Flush(), "RPC c returned result that differs from the majority")
+	})
+	t.Run("Failed reads do not count towards quorum", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b", "c")
+		expectedErr := errors.New("rpc failed")
+		_, err := DoQuorumRead(tests.Context(t), mn, 2, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			if rpc == "a" {
+				return "good", nil
+			}
+			return "", expectedErr
+		}, key)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, mn.SvcErrBuffer.Flush())
+	})
+	t.Run("Fails, if RPCs disagree", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b")
+		_, err := DoQuorumRead(tests.Context(t), mn, 2, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			return string(rpc), nil
+		}, key)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+		// neither RPC is in the majority, so neither is reported
+		require.NoError(t, mn.SvcErrBuffer.Flush())
+	})
+	t.Run("Fails, if results are tied", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b", "c", "d")
+		_, err := DoQuorumRead(tests.Context(t), mn, 2, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			if rpc == "a" || rpc == "b" {
+				return "first", nil
+			}
+			return "second", nil
+		}, key)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+		require.ErrorContains(t, err, "tied")
+		require.NoError(t, mn.SvcErrBuffer.Flush())
+	})
+	t.Run("Reports minority, if quorum is not reached", func(t *testing.T) {
+		t.Parallel()
+		mn := newQuorumReadMultiNode(t, "a", "b", "c")
+		_, err := DoQuorumRead(tests.Context(t), mn, 3, func(ctx context.Context, rpc quorumReadRPC) (string, error) {
+			if rpc == "c" {
+				return "bad", nil
+			}
+			return "good", nil
+		}, key)
+		require.ErrorIs(t, err, ErrQuorumNotReached)
+		require.ErrorContains(t, mn.SvcErrBuffer.Flush(), "RPC c returned result that differs from the majority")
+	})
+}
@@ -34,6 +34,7 @@ type multiNodeOpts struct {
 	chainID               types.ID
 	chainFamily           string
 	deathDeclarationDelay time.Duration
+	hedgedReads           HedgedReadsConfig
 }
 
 func newTestMultiNode(t *testing.T, opts multiNodeOpts) testMultiNode {
@@ -42,7 +43,7 @@ func newTestMultiNode(t *testing.T, opts multiNodeOpts) testMultiNode {
 	}
 
 	result := NewMultiNode[types.ID, multiNodeRPCClient](
-		opts.logger, opts.selectionMode, opts.leaseDuration, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay)
+		opts.logger, opts.selectionMode, opts.leaseDuration, opts.nodes, opts.sendonlys, opts.chainID, opts.chainFamily, opts.deathDeclarationDelay, opts.hedgedReads)
 	return testMultiNode{
 		result,
 	}
@@ -273,6 +274,23 @@ func TestMultiNode_CheckLease(t *testing.T) {
 		require.NoError(t, err)
 		tests.RequireLogMessage(t, observedLogs, "Best node switching is disabled")
 	})
+	t.Run("Latency weighted selection defaults lease check period", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		node := newHealthyNode(t, chainID)
+		lggr, observedLogs := logger.TestObserved(t, zap.InfoLevel)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeLatencyWeighted,
+			chainID:       chainID,
+			logger:        lggr,
+			nodes:         []Node[types.ID, multiNodeRPCClient]{node},
+			leaseDuration: 0,
+		})
+		defer func() { assert.NoError(t, mn.Close()) }()
+		err := mn.Start(tests.Context(t))
+		require.NoError(t, err)
+		tests.RequireLogMessage(t, observedLogs, fmt.Sprintf("The MultiNode will switch to best node every %s", defaultLatencyWeightedLeaseDuration))
+	})
 	t.Run("Lease check updates active node", func(t *testing.T) {
 		t.Parallel()
 		chainID := types.RandomID()
@@ -336,6 +354,32 @@ func TestMultiNode_CheckLease(t *testing.T) {
 		states := mn.NodeStates()
 		assert.Equal(t, expectedResult, states)
 	})
+	t.Run("NodeScores returns nil if selection mode does not use scores", func(t *testing.T) {
+		t.Parallel()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeHighestHead,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, multiNodeRPCClient]{newMockNode[types.ID, multiNodeRPCClient](t)},
+		})
+		assert.Nil(t, mn.NodeScores())
+	})
+	t.Run("NodeScores returns scores of primary nodes", func(t *testing.T) {
+		t.Parallel()
+		opts := multiNodeOpts{
+			selectionMode: NodeSelectionModeLatencyWeighted,
+			chainID:       types.RandomID(),
+		}
+		expectedResult := map[string]float64{"node_1": 0.9, "node_2": 0.1}
+		for name, score := range expectedResult {
+			node := newMockNode[types.ID, multiNodeRPCClient](t)
+			node.On("Name").Return(name).Once()
+			node.On("Score").Return(score).Once()
+			opts.nodes = append(opts.nodes, node)
+		}
+
+		mn := newTestMultiNode(t, opts)
+		assert.Equal(t, expectedResult, mn.NodeScores())
+	})
 }
 
 func TestMultiNode_selectNode(t *testing.T) {
@@ -79,6 +79,9 @@ type Node[
 	ConfiguredChainID() CHAIN_ID
 	// Order - returns priority order configured for the RPC
 	Order() int32
+	// Score - returns score of the RPC in range [0, 1] based on latency and error rate of the recent requests
+	// made during node lifecycle. Higher is better.
+	Score() float64
 	// Start - starts health checks
 	Start(context.Context) error
 	Close() error
@@ -109,6 +112,8 @@ type node[
 
 	poolInfoProvider PoolChainInfoProvider
 
+	requestStats nodeRequestStats
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promPoolRPCNodeScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_score",
+		Help: "Score of the given RPC node in range [0, 1] based on latency and error rate of the recent requests",
+	}, []string{"chainID", "nodeName"})
+)
+
+const (
+	// nodeScoreWindowSize - number of the most recent requests used to calculate node's score
+	nodeScoreWindowSize = 20
+	// nodeScoreReferenceLatency - latency at which a node that never fails gets score of 0.5
+	nodeScoreReferenceLatency = 100 * time.Millisecond
+)
+
+type requestSample struct {
+	latency time.Duration
+	failed  bool
+}
+
+// nodeRequestStats keeps a moving window of the most recent requests' outcomes.
+// Zero value is ready to use.
+type nodeRequestStats struct {
+	mu      sync.RWMutex
+	samples [nodeScoreWindowSize]requestSample
+	next    int
+	count   int
+}
+
+func (s *nodeRequestStats) record(latency time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.samples[s.next] = requestSample{latency: latency, failed: failed}
+	s.next = (s.next + 1) % nodeScoreWindowSize
+	if s.count < nodeScoreWindowSize {
+		s.count++
+	}
+}
+
+// score - returns value in range [0, 1]. Node without any observations is considered to be perfect (score 1) to give
+// it a chance to be selected. Latency of failed requests is taken into account, as caller had to wait for them.
+func (s *nodeRequestStats) score() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.count == 0 {
+		return 1
+	}
+
+	var failures int
+	var totalLatency time.Duration
+	for _, sample := range s.samples[:s.count] {
+		if sample.failed {
+			failures++
+		}
+		totalLatency += sample.latency
+	}
+
+	successRate := 1 - float64(failures)/float64(s.count)
+	meanLatency := totalLatency / time.Duration(s.count)
+	latencyFactor := float64(nodeScoreReferenceLatency) / float64(nodeScoreReferenceLatency+meanLatency)
+	return successRate * latencyFactor
+}
+
+// recordRequest - records outcome of the request made to the RPC and updates node's score metric
+func (n *node[CHAIN_ID, HEAD, RPC]) recordRequest(latency time.Duration, err error) {
+	n.requestStats.record(latency, err != nil)
+	promPoolRPCNodeScore.WithLabelValues(n.chainID.String(), n.name).Set(n.requestStats.score())
+}
+
+func (n *node[CHAIN_ID, HEAD, RPC]) Score() float64 {
+	return n.requestStats.score()
+}
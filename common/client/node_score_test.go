@@ -0,0 +1,44 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeRequestStats_Score(t *testing.T) {
+	t.Parallel()
+
+	t.Run("node without observations has perfect score", func(t *testing.T) {
+		var stats nodeRequestStats
+		assert.Equal(t, 1.0, stats.score())
+	})
+
+	t.Run("score reflects latency", func(t *testing.T) {
+		var fast, slow nodeRequestStats
+		fast.record(10*time.Millisecond, false)
+		slow.record(nodeScoreReferenceLatency, false)
+		assert.Greater(t, fast.score(), slow.score())
+		assert.InDelta(t, 0.5, slow.score(), 1e-9)
+	})
+
+	t.Run("score reflects error rate", func(t *testing.T) {
+		var stats nodeRequestStats
+		stats.record(0, false)
+		stats.record(0, true)
+		assert.InDelta(t, 0.5, stats.score(), 1e-9)
+	})
+
+	t.Run("old observations are evicted from the window", func(t *testing.T) {
+		var stats nodeRequestStats
+		for i := 0; i < nodeScoreWindowSize; i++ {
+			stats.record(0, true)
+		}
+		assert.Equal(t, 0.0, stats.score())
+		for i := 0; i < nodeScoreWindowSize; i++ {
+			stats.record(0, false)
+		}
+		assert.Equal(t, 1.0, stats.score())
+	})
+}
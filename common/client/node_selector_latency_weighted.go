@@ -0,0 +1,42 @@
+package client
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] []Node[CHAIN_ID, RPC]
+
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+	return latencyWeightedNodeSelector[CHAIN_ID, RPC](nodes)
+}
+
+// Select returns alive node with the highest score. Slow or failing nodes remain available, but are only selected
+// if there are no better alternatives. Ties are resolved by node's priority.
+func (s latencyWeightedNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	bestScore := -1.0
+	var bestNodes []Node[CHAIN_ID, RPC]
+	for _, n := range s {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		score := n.Score()
+		if score > bestScore {
+			bestScore = score
+			bestNodes = nil
+		}
+		if score == bestScore {
+			bestNodes = append(bestNodes, n)
+		}
+	}
+	return firstOrHighestPriority(bestNodes)
+}
+
+func (s latencyWeightedNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
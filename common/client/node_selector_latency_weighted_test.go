@@ -0,0 +1,78 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+
+	t.Run("selects alive node with the highest score", func(t *testing.T) {
+		var nodes []Node[types.ID, nodeClient]
+		for _, tc := range []struct {
+			state nodeState
+			score float64
+		}{
+			{nodeStateOutOfSync, 1},
+			{nodeStateAlive, 0.3},
+			{nodeStateAlive, 0.8},
+			{nodeStateAlive, 0.5},
+		} {
+			node := newMockNode[types.ID, nodeClient](t)
+			node.On("State").Return(tc.state)
+			node.On("Score").Maybe().Return(tc.score)
+			node.On("Order").Maybe().Return(int32(1))
+			nodes = append(nodes, node)
+		}
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("same score but different order", func(t *testing.T) {
+		var nodes []Node[types.ID, nodeClient]
+		for _, order := range []int32{3, 1, 2} {
+			node := newMockNode[types.ID, nodeClient](t)
+			node.On("State").Return(nodeStateAlive)
+			node.On("Score").Return(0.7)
+			node.On("Order").Return(order)
+			nodes = append(nodes, node)
+		}
+
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+	var nodes []Node[types.ID, nodeClient]
+
+	for i := 0; i < 3; i++ {
+		node := newMockNode[types.ID, nodeClient](t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(nodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(nodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+	assert.Nil(t, selector.Select())
+}
@@ -43,7 +43,7 @@ func newTestTransactionSender(t *testing.T, chainID types.ID, lggr logger.Logger
 	sendOnlyNodes []SendOnlyNode[types.ID, SendTxRPCClient[any]],
 ) (*sendTxMultiNode, *TransactionSender[any, types.ID, SendTxRPCClient[any]]) {
 	mn := sendTxMultiNode{NewMultiNode[types.ID, SendTxRPCClient[any]](
-		lggr, NodeSelectionModeRoundRobin, 0, nodes, sendOnlyNodes, chainID, "chainFamily", 0)}
+		lggr, NodeSelectionModeRoundRobin, 0, nodes, sendOnlyNodes, chainID, "chainFamily", 0, HedgedReadsConfig{})}
 	err := mn.StartOnce("startedTestMultiNode", func() error { return nil })
 	require.NoError(t, err)
 
@@ -58,6 +58,24 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promTimeUntilSequenced = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "tx_manager_time_until_tx_sequenced",
+		Help: "The amount of time elapsed from when a transaction is enqueued to until it is assigned a sequence, per priority lane.",
+		Buckets: []float64{
+			float64(500 * time.Millisecond),
+			float64(time.Second),
+			float64(5 * time.Second),
+			float64(15 * time.Second),
+			float64(30 * time.Second),
+			float64(time.Minute),
+			float64(2 * time.Minute),
+			float64(5 * time.Minute),
+		},
+	}, []string{"chainID", "priority"})
+	promTxsPromoted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_txs_promoted",
+		Help: "The number of lower priority transactions that were broadcast before higher priority ones because they waited longer than Transactions.PriorityMaxWait.",
+	}, []string{"chainID", "priority"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -675,7 +693,8 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	priorityMaxWait := eb.txConfig.PriorityMaxWait()
+	etx, promoted, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID, priorityMaxWait)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			// Finish. No more transactions left to process. Hoorah!
@@ -689,6 +708,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) next
 		return nil, err
 	}
 	etx.Sequence = &sequence
+	observeTimeUntilSequenced(eb.chainID, etx.Priority, etx.CreatedAt, time.Now(), promoted)
 	return etx, nil
 }
 
@@ -772,6 +792,13 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) save
 	return eb.txStore.UpdateTxFatalError(ctx, etx)
 }
 
+func observeTimeUntilSequenced[CHAIN_ID types.ID](chainID CHAIN_ID, priority txmgrtypes.TxPriority, createdAt, sequencedAt time.Time, promoted bool) {
+	promTimeUntilSequenced.WithLabelValues(chainID.String(), priority.String()).Observe(float64(sequencedAt.Sub(createdAt)))
+	if promoted {
+		promTxsPromoted.WithLabelValues(chainID.String(), priority.String()).Inc()
+	}
+}
+
 func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, createdAt, broadcastAt time.Time) {
 	duration := float64(broadcastAt.Sub(createdAt))
 	promTimeUntilBroadcast.WithLabelValues(chainID.String()).Observe(duration)
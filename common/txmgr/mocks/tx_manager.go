@@ -664,6 +664,65 @@ func (_c *TxManager_GetForwarderForEOAOCR2Feeds_Call[CHAIN_ID, HEAD, ADDR, TX_HA
 	return _c
 }
 
+// GetFromAddressLoads provides a mock function with given fields: ctx, addresses
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetFromAddressLoads(ctx context.Context, addresses []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error) {
+	ret := _m.Called(ctx, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFromAddressLoads")
+	}
+
+	var r0 []txmgrtypes.FromAddressLoad[ADDR]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error)); ok {
+		return rf(ctx, addresses)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ADDR) []txmgrtypes.FromAddressLoad[ADDR]); ok {
+		r0 = rf(ctx, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgrtypes.FromAddressLoad[ADDR])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ADDR) error); ok {
+		r1 = rf(ctx, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_GetFromAddressLoads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFromAddressLoads'
+type TxManager_GetFromAddressLoads_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// GetFromAddressLoads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []ADDR
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetFromAddressLoads(ctx interface{}, addresses interface{}) *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("GetFromAddressLoads", ctx, addresses)}
+}
+
+func (_c *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, addresses []ADDR)) *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ADDR))
+	})
+	return _c
+}
+
+func (_c *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 []txmgrtypes.FromAddressLoad[ADDR], _a1 error) *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error)) *TxManager_GetFromAddressLoads_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransactionStatus provides a mock function with given fields: ctx, transactionID
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetTransactionStatus(ctx context.Context, transactionID string) (pkgtypes.TransactionStatus, error) {
 	ret := _m.Called(ctx, transactionID)
@@ -925,6 +984,64 @@ func (_c *TxManager_RegisterResumeCallback_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, B
 	return _c
 }
 
+// RepairSequenceGaps provides a mock function with given fields: ctx, addr, dryRun
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, dryRun bool) (txmgrtypes.SequenceGapReport[ADDR, SEQ], error) {
+	ret := _m.Called(ctx, addr, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairSequenceGaps")
+	}
+
+	var r0 txmgrtypes.SequenceGapReport[ADDR, SEQ]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, bool) (txmgrtypes.SequenceGapReport[ADDR, SEQ], error)); ok {
+		return rf(ctx, addr, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR, bool) txmgrtypes.SequenceGapReport[ADDR, SEQ]); ok {
+		r0 = rf(ctx, addr, dryRun)
+	} else {
+		r0 = ret.Get(0).(txmgrtypes.SequenceGapReport[ADDR, SEQ])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR, bool) error); ok {
+		r1 = rf(ctx, addr, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_RepairSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairSequenceGaps'
+type TxManager_RepairSequenceGaps_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// RepairSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addr ADDR
+//   - dryRun bool
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx interface{}, addr interface{}, dryRun interface{}) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("RepairSequenceGaps", ctx, addr, dryRun)}
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, addr ADDR, dryRun bool)) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(_a0 txmgrtypes.SequenceGapReport[ADDR, SEQ], _a1 error) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR, bool) (txmgrtypes.SequenceGapReport[ADDR, SEQ], error)) *TxManager_RepairSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reset provides a mock function with given fields: addr, abandon
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Reset(addr ADDR, abandon bool) error {
 	ret := _m.Called(addr, abandon)
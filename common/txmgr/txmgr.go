@@ -51,6 +51,11 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// RepairSequenceGaps detects sequence gaps for the address and, unless dryRun is set, repairs them
+	RepairSequenceGaps(ctx context.Context, addr ADDR, dryRun bool) (txmgrtypes.SequenceGapReport[ADDR, SEQ], error)
+	// GetFromAddressLoads returns the number of in-flight transactions and the stuck status for each of the given
+	// addresses that is enabled on this chain
+	GetFromAddressLoads(ctx context.Context, addresses []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -109,6 +114,7 @@ type Txm[
 	confirmer          *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	tracker            *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	finalizer          txmgrtypes.Finalizer[BLOCK_HASH, HEAD]
+	gapRepairer        txmgrtypes.SequenceGapRepairer[ADDR, SEQ]
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
@@ -145,6 +151,7 @@ func NewTxm[
 	resender *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	tracker *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	finalizer txmgrtypes.Finalizer[BLOCK_HASH, HEAD],
+	gapRepairer txmgrtypes.SequenceGapRepairer[ADDR, SEQ],
 	newErrorClassifierFunc NewErrorClassifier,
 ) *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	b := Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -168,6 +175,7 @@ func NewTxm[
 		tracker:            tracker,
 		newErrorClassifier: newErrorClassifierFunc,
 		finalizer:          finalizer,
+		gapRepairer:        gapRepairer,
 	}
 
 	if txCfg.ResendAfterThreshold() <= 0 {
@@ -178,6 +186,9 @@ func NewTxm[
 	} else {
 		b.logger.Info("TxReaper: Disabled")
 	}
+	if gapRepairer == nil || gapRepairer.AutoRepairInterval() <= 0 {
+		b.logger.Info("SequenceGapRepairer: Automatic repair disabled")
+	}
 
 	return &b
 }
@@ -240,7 +251,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Reset(addr
 		}
 
 		b.reset <- reset{f, done}
-		err = <-done
+		if doneErr := <-done; doneErr != nil {
+			err = doneErr
+		}
 	})
 	if !ok {
 		return errors.New("not started")
@@ -248,6 +261,115 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Reset(addr
 	return err
 }
 
+// RepairSequenceGaps detects sequence gaps for the given address. Unless dryRun is set, it stops Broadcaster/Confirmer,
+// repairs the gaps, then starts them again
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, dryRun bool) (report txmgrtypes.SequenceGapReport[ADDR, SEQ], err error) {
+	if b.gapRepairer == nil {
+		return report, errors.New("sequence gap repair is not supported on this chain")
+	}
+	ok := b.IfStarted(func() {
+		if err = b.checkEnabled(ctx, addr); err != nil {
+			return
+		}
+		if dryRun {
+			report, err = b.gapRepairer.RepairSequenceGaps(ctx, addr, true)
+			return
+		}
+
+		done := make(chan error)
+		f := func() {
+			report, err = b.gapRepairer.RepairSequenceGaps(ctx, addr, false)
+		}
+		select {
+		case b.reset <- reset{f, done}:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+		if doneErr := <-done; doneErr != nil {
+			err = doneErr
+		}
+	})
+	if !ok {
+		return report, errors.New("not started")
+	}
+	return report, err
+}
+
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetFromAddressLoads(ctx context.Context, addresses []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error) {
+	enabledAddresses, err := b.keyStore.EnabledAddressesForChain(ctx, b.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled addresses for chain: %w", err)
+	}
+	enabled := make(map[ADDR]struct{}, len(enabledAddresses))
+	for _, addr := range enabledAddresses {
+		enabled[addr] = struct{}{}
+	}
+
+	var candidates []ADDR
+	for _, addr := range addresses {
+		if _, ok := enabled[addr]; ok {
+			candidates = append(candidates, addr)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	inFlight, err := b.txStore.CountInFlightTransactions(ctx, candidates, b.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count in-flight transactions: %w", err)
+	}
+
+	loads := make([]txmgrtypes.FromAddressLoad[ADDR], 0, len(candidates))
+	for _, addr := range candidates {
+		loads = append(loads, txmgrtypes.FromAddressLoad[ADDR]{
+			Address:  addr,
+			InFlight: inFlight[addr],
+			Stuck:    b.confirmer.stuckTxDetector.HasStuckTransactions(addr),
+		})
+	}
+	return loads, nil
+}
+
+// repairSequenceGaps repairs the sequence gaps of all enabled addresses that have any.
+// Returns nil if no address has gaps, otherwise a reset which repairs them.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) repairSequenceGaps(ctx context.Context) *reset {
+	enabledAddresses, err := b.keyStore.EnabledAddressesForChain(ctx, b.chainID)
+	if err != nil {
+		b.logger.Errorw("Failed to load enabled addresses for sequence gap repair", "err", err)
+		return nil
+	}
+	var gapped []ADDR
+	for _, addr := range enabledAddresses {
+		report, err := b.gapRepairer.RepairSequenceGaps(ctx, addr, true)
+		if err != nil {
+			b.logger.Errorw("Failed to detect sequence gaps", "address", addr, "err", err)
+			continue
+		}
+		if report.HasGaps() {
+			b.logger.Warnw("Detected sequence gaps", "address", addr, "missingSequences", report.MissingSequences)
+			gapped = append(gapped, addr)
+		}
+	}
+	if len(gapped) == 0 {
+		return nil
+	}
+	return &reset{
+		f: func() {
+			for _, addr := range gapped {
+				report, err := b.gapRepairer.RepairSequenceGaps(ctx, addr, false)
+				if err != nil {
+					b.logger.Errorw("Failed to repair sequence gaps", "address", addr, "err", err)
+					b.SvcErrBuffer.Append(err)
+					continue
+				}
+				b.logger.Infow("Repaired sequence gaps", "address", addr, "filledSequences", report.FilledSequences, "resequencedTxIDs", report.ResequencedTxIDs, "requeuedTxIDs", report.RequeuedTxIDs, "skippedTxs", report.SkippedTxs)
+			}
+		},
+		done: make(chan error),
+	}
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while Broadcaster or Confirmer are running
@@ -324,6 +446,13 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runLoop()
 	var stopped bool
 	var stopOnce sync.Once
 
+	var gapRepairTick <-chan time.Time
+	if b.gapRepairer != nil && b.gapRepairer.AutoRepairInterval() > 0 {
+		ticker := time.NewTicker(utils.WithJitter(b.gapRepairer.AutoRepairInterval()))
+		defer ticker.Stop()
+		gapRepairTick = ticker.C
+	}
+
 	// execReset is defined as an inline function here because it closes over
 	// eb, ec and stopped
 	execReset := func(ctx context.Context, r *reset) {
@@ -435,6 +564,13 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runLoop()
 				continue
 			}
 			execReset(ctx, &reset)
+		case <-gapRepairTick:
+			if stopped {
+				continue
+			}
+			if r := b.repairSequenceGaps(ctx); r != nil {
+				execReset(ctx, r)
+			}
 		case <-b.chStop:
 			// close and exit
 			//
@@ -717,6 +853,16 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+// RepairSequenceGaps does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RepairSequenceGaps(ctx context.Context, addr ADDR, dryRun bool) (report txmgrtypes.SequenceGapReport[ADDR, SEQ], err error) {
+	return report, errors.New(n.ErrMsg)
+}
+
+// GetFromAddressLoads does nothing, null functionality
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetFromAddressLoads(ctx context.Context, addresses []ADDR) ([]txmgrtypes.FromAddressLoad[ADDR], error) {
+	return nil, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// FromAddressLoad describes how busy a sending address is, so callers can spread transactions across several addresses
+type FromAddressLoad[ADDR types.Hashable] struct {
+	Address ADDR
+	// InFlight is the number of unstarted and unconfirmed transactions from the address
+	InFlight uint32
+	// Stuck is true if the StuckTxDetector found a terminally stuck transaction for the address which was not purged yet
+	Stuck bool
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// SequenceGapReport describes the sequence gaps found for an address and what was done to repair them
+type SequenceGapReport[ADDR types.Hashable, SEQ types.Sequence] struct {
+	Address ADDR
+	// NextSequenceOnChain is the pending sequence reported by the chain, i.e. the lowest sequence that is not yet used on-chain
+	NextSequenceOnChain SEQ
+	// HighestStoredSequence is the highest sequence assigned to a transaction in the local store, if any
+	HighestStoredSequence *SEQ
+	// MissingSequences are the sequences between NextSequenceOnChain and HighestStoredSequence that no in-flight transaction is using
+	MissingSequences []SEQ
+	// FilledSequences are the missing sequences that were filled with no-op transactions
+	FilledSequences []SEQ
+	// ResequencedTxIDs are the IDs of the stuck transactions above the first gap that were abandoned, or would be if
+	// DryRun is set, to queue them again with new sequences
+	ResequencedTxIDs []int64
+	// RequeuedTxIDs are the IDs of the new transactions queued for the resequenced transactions, in the same order
+	RequeuedTxIDs []int64
+	// SkippedTxs are the transactions above the first gap that were not resequenced, and why
+	SkippedTxs []SkippedTx
+	// DryRun is true if the gaps were only detected and nothing was changed
+	DryRun bool
+}
+
+// SkippedTx is a transaction that was not resequenced
+type SkippedTx struct {
+	ID     int64
+	Reason string
+}
+
+// HasGaps returns true if any missing sequences were detected
+func (r SequenceGapReport[ADDR, SEQ]) HasGaps() bool {
+	return len(r.MissingSequences) > 0
+}
+
+// SequenceGapRepairer detects and repairs gaps between the sequence expected by the chain and the sequences of the
+// transactions stored locally. Such gaps prevent every later transaction from being included.
+type SequenceGapRepairer[ADDR types.Hashable, SEQ types.Sequence] interface {
+	// RepairSequenceGaps detects the sequence gaps for the address and repairs them, unless dryRun is set.
+	// It must not be run while the Broadcaster or Confirmer are running, unless dryRun is set.
+	RepairSequenceGaps(ctx context.Context, addr ADDR, dryRun bool) (SequenceGapReport[ADDR, SEQ], error)
+	// AutoRepairInterval returns how often the gaps should be repaired automatically. Zero disables the automatic repair.
+	AutoRepairInterval() time.Duration
+}
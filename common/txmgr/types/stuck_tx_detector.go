@@ -21,6 +21,8 @@ type StuckTxDetector[
 	LoadPurgeBlockNumMap(ctx context.Context, addresses []ADDR) error
 	// Sets the last purged block num after a transaction has been successfully purged with receipt
 	SetPurgeBlockNum(fromAddress ADDR, blockNum int64)
+	// Returns true if a terminally stuck transaction was detected for the address and it was not purged yet
+	HasStuckTransactions(fromAddress ADDR) bool
 	// Returns the error message to set in the transaction error field to mark it as terminally stuck
 	StuckTxFatalError() string
 }
@@ -64,6 +64,48 @@ func (s TxAttemptState) String() (str string) {
 	return txAttemptStateStrings[0]
 }
 
+// TxPriority determines the order in which the Broadcaster assigns sequences to unstarted transactions of the same
+// from address. Transactions of higher priority are broadcast first, transactions of equal priority in FIFO order.
+type TxPriority int8
+
+const (
+	// TxPriorityBulk is used for transactions that can tolerate delays, e.g. batched or periodic sends
+	TxPriorityBulk TxPriority = iota - 1
+	// TxPriorityNormal is the default priority
+	TxPriorityNormal
+	// TxPriorityCritical is used for time sensitive transactions, e.g. OCR transmissions
+	TxPriorityCritical
+)
+
+// TxPriorities lists all priorities from the highest to the lowest
+var TxPriorities = []TxPriority{TxPriorityCritical, TxPriorityNormal, TxPriorityBulk}
+
+var txPriorityStrings = map[TxPriority]string{
+	TxPriorityBulk:     "bulk",
+	TxPriorityNormal:   "normal",
+	TxPriorityCritical: "critical",
+}
+
+func (p TxPriority) String() string {
+	if s, ok := txPriorityStrings[p]; ok {
+		return s
+	}
+	return fmt.Sprintf("TxPriority(%d)", p)
+}
+
+// ParseTxPriority parses the name of a priority. An empty string is parsed as TxPriorityNormal.
+func ParseTxPriority(s string) (TxPriority, error) {
+	if s == "" {
+		return TxPriorityNormal, nil
+	}
+	for p, name := range txPriorityStrings {
+		if strings.EqualFold(s, name) {
+			return p, nil
+		}
+	}
+	return TxPriorityNormal, fmt.Errorf("unknown tx priority %q, expected one of: critical, normal, bulk", s)
+}
+
 type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// IdempotencyKey is a globally unique ID set by the caller, to prevent accidental creation of duplicated Txs during retries or crash recovery.
 	// If this field is set, the TXM will first search existing Txs with this field.
@@ -93,6 +135,12 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Blobs is the optional data of an EIP-4844 blob transaction, currently only supported on EVM chains
+	Blobs [][]byte
+
+	// Priority determines the order in which unstarted transactions of the same from address are broadcast
+	Priority TxPriority
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -232,6 +280,12 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Blobs is the optional data of an EIP-4844 blob transaction, currently only supported on EVM chains
+	Blobs [][]byte `json:"-"`
+
+	// Priority determines the order in which unstarted transactions of the same from address are broadcast
+	Priority TxPriority
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTxAttemptState(t *testing.T) {
@@ -48,3 +49,37 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "critical", TxPriorityCritical.String())
+		assert.Equal(t, "normal", TxPriorityNormal.String())
+		assert.Equal(t, "bulk", TxPriorityBulk.String())
+		assert.Equal(t, "TxPriority(5)", TxPriority(5).String())
+	})
+
+	t.Run("ParseTxPriority", func(t *testing.T) {
+		for _, p := range TxPriorities {
+			parsed, err := ParseTxPriority(p.String())
+			require.NoError(t, err)
+			assert.Equal(t, p, parsed)
+		}
+
+		parsed, err := ParseTxPriority("")
+		require.NoError(t, err)
+		assert.Equal(t, TxPriorityNormal, parsed)
+
+		parsed, err = ParseTxPriority("CRITICAL")
+		require.NoError(t, err)
+		assert.Equal(t, TxPriorityCritical, parsed)
+
+		_, err = ParseTxPriority("urgent")
+		require.ErrorContains(t, err, "unknown tx priority")
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		assert.Greater(t, TxPriorityCritical, TxPriorityNormal)
+		assert.Greater(t, TxPriorityNormal, TxPriorityBulk)
+		assert.Equal(t, TxPriorityNormal, TxPriority(0))
+	})
+}
@@ -0,0 +1,307 @@
+package bridges
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// HeaderTimestamp is the header carrying the unix time at which a request was signed.
+	HeaderTimestamp = "X-Chainlink-Timestamp"
+	// HeaderSignature is the header carrying the hex encoded HMAC-SHA256 signature of a request.
+	HeaderSignature = "X-Chainlink-Signature"
+
+	// DefaultSignatureMaxSkew is the maximum age of a signed request accepted by default.
+	DefaultSignatureMaxSkew = 5 * time.Minute
+)
+
+// AuthScheme is the scheme the node uses to authenticate its requests to a bridge.
+type AuthScheme string
+
+const (
+	// AuthSchemeNone sends requests to the bridge without further authentication.
+	AuthSchemeNone AuthScheme = "none"
+	// AuthSchemeHMAC signs request bodies and their timestamps with a secret shared with the bridge.
+	AuthSchemeHMAC AuthScheme = "hmac"
+	// AuthSchemeMTLS presents a client certificate to the bridge.
+	AuthSchemeMTLS AuthScheme = "mtls"
+)
+
+// ParseAuthScheme returns the AuthScheme named s, which defaults to none if empty.
+func ParseAuthScheme(s string) (AuthScheme, error) {
+	switch scheme := AuthScheme(s); scheme {
+	case "":
+		return AuthSchemeNone, nil
+	case AuthSchemeNone, AuthSchemeHMAC, AuthSchemeMTLS:
+		return scheme, nil
+	default:
+		return "", fmt.Errorf("unknown bridge auth scheme %q, expected one of none, hmac or mtls", s)
+	}
+}
+
+// UnmarshalJSON parses and validates an AuthScheme.
+func (s *AuthScheme) UnmarshalJSON(input []byte) error {
+	var aux string
+	if err := json.Unmarshal(input, &aux); err != nil {
+		return err
+	}
+	if aux == "" {
+		// leaves the scheme unchanged on updates
+		*s = ""
+		return nil
+	}
+	scheme, err := ParseAuthScheme(aux)
+	*s = scheme
+	return err
+}
+
+// Value returns this instance serialized for database storage.
+func (s AuthScheme) Value() (driver.Value, error) {
+	if s == "" {
+		return string(AuthSchemeNone), nil
+	}
+	return string(s), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (s *AuthScheme) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*s = AuthScheme(v)
+	case []byte:
+		*s = AuthScheme(v)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to AuthScheme", value, value)
+	}
+	return nil
+}
+
+// BridgeAuth holds the credentials of the auth scheme of a bridge.
+// Certificates and keys are PEM encoded.
+type BridgeAuth struct {
+	HMACSecret        string `json:"hmacSecret,omitempty"`
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	ClientKey         string `json:"clientKey,omitempty"`
+	// CACertificate optionally replaces the system roots when verifying the bridge.
+	CACertificate string `json:"caCertificate,omitempty"`
+}
+
+// Validate checks that the credentials are complete for scheme.
+func (a BridgeAuth) Validate(scheme AuthScheme) error {
+	switch scheme {
+	case AuthSchemeHMAC:
+		if a.HMACSecret == "" {
+			return pkgerrors.New("hmac auth requires a secret")
+		}
+	case AuthSchemeMTLS:
+		if a.ClientCertificate == "" || a.ClientKey == "" {
+			return pkgerrors.New("mtls auth requires a client certificate and key")
+		}
+		_, err := a.TLSConfig()
+		return err
+	}
+	return nil
+}
+
+// TLSConfig returns the TLS configuration presenting the client certificate.
+func (a BridgeAuth) TLSConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(a.ClientCertificate), []byte(a.ClientKey))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "invalid client certificate")
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if a.CACertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(a.CACertificate)) {
+			return nil, pkgerrors.New("invalid CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// EncryptedAuth is a BridgeAuth encrypted with the keystore password.
+type EncryptedAuth struct {
+	keystore.CryptoJSON
+}
+
+// EncryptAuth encrypts auth with password.
+func EncryptAuth(auth BridgeAuth, password string, scryptParams utils.ScryptParams) (*EncryptedAuth, error) {
+	b, err := json.Marshal(auth)
+	if err != nil {
+		return nil, err
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(b, []byte(password), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "could not encrypt bridge auth")
+	}
+	return &EncryptedAuth{CryptoJSON: cryptoJSON}, nil
+}
+
+// Decrypt returns the BridgeAuth decrypted with password.
+func (e EncryptedAuth) Decrypt(password string) (auth BridgeAuth, err error) {
+	b, err := keystore.DecryptDataV3(e.CryptoJSON, password)
+	if err != nil {
+		return auth, pkgerrors.Wrap(err, "could not decrypt bridge auth")
+	}
+	err = json.Unmarshal(b, &auth)
+	return auth, err
+}
+
+// Scan reads the database value and returns an instance.
+func (e *EncryptedAuth) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("unable to convert %v of %T to EncryptedAuth", value, value)
+	}
+	return json.Unmarshal(b, e)
+}
+
+// Value returns this instance serialized for database storage.
+func (e EncryptedAuth) Value() (driver.Value, error) {
+	return json.Marshal(e)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of timestamp and body, separated
+// by a dot, keyed with secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureHeaders returns the HeaderTimestamp and HeaderSignature values
+// signing body at now.
+func SignatureHeaders(secret string, body []byte, now time.Time) (timestamp string, signature string) {
+	ts := now.Unix()
+	return strconv.FormatInt(ts, 10), Sign(secret, ts, body)
+}
+
+// VerifySignature checks that header carries a valid signature of body that
+// is at most maxSkew away from now. External adapters use it to verify
+// requests from the node, and the node to verify callbacks of async bridges.
+func VerifySignature(secret string, header http.Header, body []byte, now time.Time, maxSkew time.Duration) error {
+	timestamp, signature := header.Get(HeaderTimestamp), header.Get(HeaderSignature)
+	if timestamp == "" || signature == "" {
+		return pkgerrors.New("request is not signed")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return pkgerrors.Wrap(err, "invalid signature timestamp")
+	}
+	if skew := now.Sub(time.Unix(ts, 0)); skew > maxSkew || skew < -maxSkew {
+		return pkgerrors.Errorf("signature timestamp is %s away from now, at most %s is allowed", skew, maxSkew)
+	}
+	expected, err := hex.DecodeString(Sign(secret, ts, body))
+	if err != nil {
+		return err
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, actual) {
+		return pkgerrors.New("invalid signature")
+	}
+	return nil
+}
+
+// Authenticator applies the auth schemes of bridges. It keeps the decrypted
+// credentials and the clients of mtls bridges in memory, as decryption is
+// deliberately slow.
+type Authenticator struct {
+	password string
+
+	decrypts singleflight.Group
+
+	mu      sync.Mutex
+	auths   map[string]BridgeAuth
+	clients map[string]*http.Client
+}
+
+// NewAuthenticator returns an Authenticator decrypting credentials with password.
+func NewAuthenticator(password string) *Authenticator {
+	return &Authenticator{
+		password: password,
+		auths:    map[string]BridgeAuth{},
+		clients:  map[string]*http.Client{},
+	}
+}
+
+// Credentials returns the decrypted credentials of bt.
+func (a *Authenticator) Credentials(bt BridgeType) (BridgeAuth, error) {
+	if bt.EncryptedAuth == nil {
+		return BridgeAuth{}, pkgerrors.Errorf("bridge %q has no credentials", bt.Name)
+	}
+	// encrypting generates a new salt, so the MAC identifies a version of the credentials
+	key := bt.EncryptedAuth.MAC
+	a.mu.Lock()
+	auth, ok := a.auths[key]
+	a.mu.Unlock()
+	if ok {
+		return auth, nil
+	}
+	// decrypt without holding the lock, so that calls of other bridges are not
+	// held up, and only once for concurrent calls of the same bridge
+	v, err, _ := a.decrypts.Do(key, func() (interface{}, error) {
+		auth, err := bt.EncryptedAuth.Decrypt(a.password)
+		if err != nil {
+			return nil, err
+		}
+		a.mu.Lock()
+		a.auths[key] = auth
+		a.mu.Unlock()
+		return auth, nil
+	})
+	if err != nil {
+		return BridgeAuth{}, pkgerrors.Wrapf(err, "bridge %q", bt.Name)
+	}
+	return v.(BridgeAuth), nil
+}
+
+// Client returns a copy of base presenting the client certificate of bt,
+// which must use the mtls scheme.
+func (a *Authenticator) Client(bt BridgeType, base *http.Client) (*http.Client, error) {
+	if bt.AuthScheme != AuthSchemeMTLS {
+		return nil, pkgerrors.Errorf("bridge %q does not use mtls", bt.Name)
+	}
+	auth, err := a.Credentials(bt)
+	if err != nil {
+		return nil, err
+	}
+	key := bt.EncryptedAuth.MAC
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if client, ok := a.clients[key]; ok {
+		return client, nil
+	}
+	transport, ok := base.Transport.(*http.Transport)
+	if !ok {
+		return nil, pkgerrors.Errorf("cannot use mtls with transport %T", base.Transport)
+	}
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "bridge %q", bt.Name)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := *base
+	client.Transport = transport
+	a.clients[key] = &client
+	return &client, nil
+}
@@ -0,0 +1,198 @@
+package bridges_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const authPassword = "p4SsW0rD1!@#_"
+
+func TestParseAuthScheme(t *testing.T) {
+	for s, expected := range map[string]bridges.AuthScheme{
+		"":     bridges.AuthSchemeNone,
+		"none": bridges.AuthSchemeNone,
+		"hmac": bridges.AuthSchemeHMAC,
+		"mtls": bridges.AuthSchemeMTLS,
+	} {
+		scheme, err := bridges.ParseAuthScheme(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, scheme)
+	}
+	_, err := bridges.ParseAuthScheme("basic")
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"data":{"coin":"BTC"}}`)
+	now := time.Unix(1700000000, 0)
+	timestamp, signature := bridges.SignatureHeaders("secret", body, now)
+	header := http.Header{}
+	header.Set(bridges.HeaderTimestamp, timestamp)
+	header.Set(bridges.HeaderSignature, signature)
+
+	assert.Equal(t, "1700000000", timestamp)
+	assert.NoError(t, bridges.VerifySignature("secret", header, body, now.Add(time.Minute), bridges.DefaultSignatureMaxSkew))
+
+	t.Run("wrong secret", func(t *testing.T) {
+		assert.EqualError(t, bridges.VerifySignature("other", header, body, now, bridges.DefaultSignatureMaxSkew), "invalid signature")
+	})
+	t.Run("tampered body", func(t *testing.T) {
+		assert.EqualError(t, bridges.VerifySignature("secret", header, []byte(`{}`), now, bridges.DefaultSignatureMaxSkew), "invalid signature")
+	})
+	t.Run("expired", func(t *testing.T) {
+		err := bridges.VerifySignature("secret", header, body, now.Add(10*time.Minute), bridges.DefaultSignatureMaxSkew)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "away from now")
+	})
+	t.Run("unsigned", func(t *testing.T) {
+		assert.EqualError(t, bridges.VerifySignature("secret", http.Header{}, body, now, bridges.DefaultSignatureMaxSkew), "request is not signed")
+	})
+}
+
+func TestBridgeType_SetAuth(t *testing.T) {
+	btr := &bridges.BridgeTypeRequest{Name: bridges.MustParseBridgeName("auth"), AuthScheme: bridges.AuthSchemeHMAC}
+	bta, bt, err := bridges.NewBridgeType(btr)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.AuthSchemeNone, bt.AuthScheme)
+
+	require.NoError(t, bt.SetAuth(btr, bta, authPassword, utils.FastScryptParams))
+	assert.Equal(t, bridges.AuthSchemeHMAC, bt.AuthScheme)
+	require.NotNil(t, bt.EncryptedAuth)
+	assert.NotEmpty(t, bta.HMACSecret)
+
+	auth, err := bridges.NewAuthenticator(authPassword).Credentials(*bt)
+	require.NoError(t, err)
+	assert.Equal(t, bta.HMACSecret, auth.HMACSecret)
+
+	_, err = bridges.NewAuthenticator("wrong").Credentials(*bt)
+	assert.Error(t, err)
+
+	t.Run("leaves auth unchanged without scheme", func(t *testing.T) {
+		require.NoError(t, bt.SetAuth(&bridges.BridgeTypeRequest{}, nil, authPassword, utils.FastScryptParams))
+		assert.Equal(t, bridges.AuthSchemeHMAC, bt.AuthScheme)
+		assert.NotNil(t, bt.EncryptedAuth)
+	})
+
+	t.Run("keeps the secret if the scheme is unchanged", func(t *testing.T) {
+		encrypted := bt.EncryptedAuth
+		bta := &bridges.BridgeTypeAuthentication{}
+		require.NoError(t, bt.SetAuth(&bridges.BridgeTypeRequest{AuthScheme: bridges.AuthSchemeHMAC}, bta, authPassword, utils.FastScryptParams))
+		assert.Equal(t, encrypted, bt.EncryptedAuth)
+		assert.Empty(t, bta.HMACSecret)
+	})
+
+	t.Run("rejects incomplete mtls credentials", func(t *testing.T) {
+		btr := &bridges.BridgeTypeRequest{AuthScheme: bridges.AuthSchemeMTLS, Auth: &bridges.BridgeAuth{ClientCertificate: "foo"}}
+		assert.EqualError(t, bt.SetAuth(btr, nil, authPassword, utils.FastScryptParams), "mtls auth requires a client certificate and key")
+	})
+
+	t.Run("removes auth", func(t *testing.T) {
+		btr := &bridges.BridgeTypeRequest{AuthScheme: bridges.AuthSchemeNone}
+		require.NoError(t, bt.SetAuth(btr, nil, authPassword, utils.FastScryptParams))
+		assert.Equal(t, bridges.AuthSchemeNone, bt.AuthScheme)
+		assert.Nil(t, bt.EncryptedAuth)
+	})
+}
+
+func TestAuthenticator_Credentials(t *testing.T) {
+	btr := &bridges.BridgeTypeRequest{Name: bridges.MustParseBridgeName("auth"), AuthScheme: bridges.AuthSchemeHMAC, Auth: &bridges.BridgeAuth{HMACSecret: "secret"}}
+	_, bt, err := bridges.NewBridgeType(btr)
+	require.NoError(t, err)
+	require.NoError(t, bt.SetAuth(btr, nil, authPassword, utils.FastScryptParams))
+
+	authenticator := bridges.NewAuthenticator(authPassword)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			auth, err := authenticator.Credentials(*bt)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "secret", auth.HMACSecret)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAuthenticator_Client(t *testing.T) {
+	certPEM, keyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(certPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	base := &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+	btr := &bridges.BridgeTypeRequest{
+		Name:       bridges.MustParseBridgeName("mtls"),
+		AuthScheme: bridges.AuthSchemeMTLS,
+		Auth: &bridges.BridgeAuth{
+			ClientCertificate: string(certPEM),
+			ClientKey:         string(keyPEM),
+			CACertificate:     string(serverCA),
+		},
+	}
+	_, bt, err := bridges.NewBridgeType(btr)
+	require.NoError(t, err)
+	require.NoError(t, bt.SetAuth(btr, nil, authPassword, utils.FastScryptParams))
+
+	authenticator := bridges.NewAuthenticator(authPassword)
+	client, err := authenticator.Client(*bt, base)
+	require.NoError(t, err)
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the base client presents no certificate
+	_, err = base.Get(server.URL)
+	assert.Error(t, err)
+
+	// clients are kept for the same credentials
+	again, err := authenticator.Client(*bt, base)
+	require.NoError(t, err)
+	assert.Same(t, client, again)
+}
+
+func newClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
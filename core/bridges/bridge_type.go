@@ -21,6 +21,14 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// FallbackURLs are tried after URL, in the order of SelectionPolicy.
+	// FallbackURLs are left unchanged on updates if omitted, and cleared if empty.
+	FallbackURLs WebURLs `json:"fallbackURLs"`
+	// SelectionPolicy is left unchanged on updates if empty
+	SelectionPolicy SelectionPolicy `json:"selectionPolicy"`
+	// AuthScheme is left unchanged on updates if empty
+	AuthScheme AuthScheme  `json:"authScheme"`
+	Auth       *BridgeAuth `json:"auth,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -48,6 +56,8 @@ type BridgeTypeAuthentication struct {
 	IncomingToken          string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	// HMACSecret is only set if it was generated for the bridge
+	HMACSecret string
 }
 
 // BridgeType is used for external adapters and has fields for
@@ -60,6 +70,10 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link
+	FallbackURLs           WebURLs
+	SelectionPolicy        SelectionPolicy
+	AuthScheme             AuthScheme
+	EncryptedAuth          *EncryptedAuth
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
@@ -92,9 +106,52 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			FallbackURLs:           btr.FallbackURLs,
+			SelectionPolicy:        btr.SelectionPolicy,
+			AuthScheme:             AuthSchemeNone,
 		}, nil
 }
 
+// Endpoints returns the URL of the bridge followed by its fallback URLs.
+func (bt BridgeType) Endpoints() []models.WebURL {
+	return append([]models.WebURL{bt.URL}, bt.FallbackURLs...)
+}
+
+// SetAuth encrypts the credentials of the auth scheme of the request with
+// password and sets them on the bridge type. A secret is generated for hmac
+// if the request does not carry one, and returned in bta.
+// It does nothing if the request does not set an auth scheme, or sets the
+// current scheme of the bridge type without credentials, so that the current
+// credentials are kept.
+func (bt *BridgeType) SetAuth(btr *BridgeTypeRequest, bta *BridgeTypeAuthentication, password string, scryptParams utils.ScryptParams) error {
+	if btr.AuthScheme == "" || (btr.AuthScheme == bt.AuthScheme && btr.Auth == nil) {
+		return nil
+	}
+	var auth BridgeAuth
+	if btr.Auth != nil {
+		auth = *btr.Auth
+	}
+	if btr.AuthScheme == AuthSchemeHMAC && auth.HMACSecret == "" {
+		auth.HMACSecret = utils.NewSecret(32)
+		if bta != nil {
+			bta.HMACSecret = auth.HMACSecret
+		}
+	}
+	if err := auth.Validate(btr.AuthScheme); err != nil {
+		return err
+	}
+	if btr.AuthScheme == AuthSchemeNone {
+		bt.AuthScheme, bt.EncryptedAuth = AuthSchemeNone, nil
+		return nil
+	}
+	encrypted, err := EncryptAuth(auth, password, scryptParams)
+	if err != nil {
+		return err
+	}
+	bt.AuthScheme, bt.EncryptedAuth = btr.AuthScheme, encrypted
+	return nil
+}
+
 // AuthenticateBridgeType returns true if the passed token matches its
 // IncomingToken, or returns false with an error.
 func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
@@ -0,0 +1,362 @@
+package bridges
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+const (
+	HealthCheckerServiceName = "BridgeHealthChecker"
+
+	healthCheckTimeout  = 5 * time.Second
+	healthCheckPageSize = 100
+	// maxConcurrentHealthChecks is the maximum number of bridges checked at once
+	maxConcurrentHealthChecks = 10
+)
+
+// SelectionPolicy is the order in which the bridge task tries the endpoints
+// of a bridge. Healthy endpoints are always tried before unhealthy ones.
+type SelectionPolicy string
+
+const (
+	// SelectionPolicyPrimary tries the URL first, then the fallback URLs in order.
+	SelectionPolicyPrimary SelectionPolicy = "primary"
+	// SelectionPolicyRoundRobin starts at the next endpoint on every request.
+	SelectionPolicyRoundRobin SelectionPolicy = "roundrobin"
+	// SelectionPolicyFastest tries the endpoints with the lowest health check latency first.
+	SelectionPolicyFastest SelectionPolicy = "fastest"
+)
+
+// ParseSelectionPolicy returns the SelectionPolicy named s, which defaults to primary if empty.
+func ParseSelectionPolicy(s string) (SelectionPolicy, error) {
+	switch policy := SelectionPolicy(s); policy {
+	case "":
+		return SelectionPolicyPrimary, nil
+	case SelectionPolicyPrimary, SelectionPolicyRoundRobin, SelectionPolicyFastest:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown bridge selection policy %q, expected one of primary, roundrobin or fastest", s)
+	}
+}
+
+// UnmarshalJSON parses and validates a SelectionPolicy.
+func (p *SelectionPolicy) UnmarshalJSON(input []byte) error {
+	var aux string
+	if err := json.Unmarshal(input, &aux); err != nil {
+		return err
+	}
+	policy, err := ParseSelectionPolicy(aux)
+	*p = policy
+	return err
+}
+
+// Value returns this instance serialized for database storage.
+func (p SelectionPolicy) Value() (driver.Value, error) {
+	if p == "" {
+		return string(SelectionPolicyPrimary), nil
+	}
+	return string(p), nil
+}
+
+// Scan reads the database value and returns an instance.
+func (p *SelectionPolicy) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*p = SelectionPolicy(v)
+	case []byte:
+		*p = SelectionPolicy(v)
+	default:
+		return fmt.Errorf("unable to convert %v of %T to SelectionPolicy", value, value)
+	}
+	return nil
+}
+
+// WebURLs are stored as a text array.
+type WebURLs []models.WebURL
+
+// Value returns this instance serialized for database storage.
+func (u WebURLs) Value() (driver.Value, error) {
+	urls := pq.StringArray{}
+	for _, webURL := range u {
+		urls = append(urls, webURL.String())
+	}
+	return urls.Value()
+}
+
+// Scan reads the database value and returns an instance.
+func (u *WebURLs) Scan(value interface{}) error {
+	var urls pq.StringArray
+	if err := urls.Scan(value); err != nil {
+		return err
+	}
+	*u = nil
+	for _, s := range urls {
+		parsed, err := url.Parse(s)
+		if err != nil {
+			return err
+		}
+		*u = append(*u, models.WebURL(*parsed))
+	}
+	return nil
+}
+
+// EndpointStatus is the result of the latest health check of an endpoint.
+type EndpointStatus string
+
+const (
+	EndpointStatusUnknown   EndpointStatus = "unknown"
+	EndpointStatusHealthy   EndpointStatus = "healthy"
+	EndpointStatusUnhealthy EndpointStatus = "unhealthy"
+)
+
+// EndpointHealth is the health of a URL of a bridge.
+type EndpointHealth struct {
+	URL       models.WebURL
+	Status    EndpointStatus
+	Latency   time.Duration
+	CheckedAt time.Time
+	Error     string
+}
+
+// HealthChecker periodically checks that the endpoints of all bridges are
+// reachable and respond with a status below 500, and orders the endpoints
+// of a bridge by health and its selection policy. Bridges with unhealthy
+// endpoints are reported in the health of the node.
+type HealthChecker struct {
+	services.Service
+	eng *services.Engine
+
+	orm      ORM
+	auth     *Authenticator
+	client   *http.Client
+	interval time.Duration
+
+	mu     sync.RWMutex
+	health map[BridgeName]map[string]EndpointHealth // keyed by URL
+	next   map[BridgeName]int
+}
+
+// NewHealthChecker returns a HealthChecker requesting the endpoints with
+// client, or with the clients of auth for mtls bridges. Periodic checks are
+// disabled if interval is 0.
+func NewHealthChecker(orm ORM, auth *Authenticator, client *http.Client, lggr logger.Logger, interval time.Duration) *HealthChecker {
+	h := &HealthChecker{
+		orm:      orm,
+		auth:     auth,
+		client:   client,
+		interval: interval,
+		health:   map[BridgeName]map[string]EndpointHealth{},
+		next:     map[BridgeName]int{},
+	}
+	h.Service, h.eng = services.Config{
+		Name:  HealthCheckerServiceName,
+		Start: h.start,
+	}.NewServiceEngine(lggr)
+	return h
+}
+
+func (h *HealthChecker) start(_ context.Context) error {
+	if h.interval == 0 {
+		h.eng.Info("Bridge health checks are disabled")
+		return nil
+	}
+	ticker := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(h.interval)
+	h.eng.GoTick(ticker, h.checkAll)
+	return nil
+}
+
+func (h *HealthChecker) checkAll(ctx context.Context) {
+	var all []BridgeType
+	for offset := 0; ; offset += healthCheckPageSize {
+		bts, count, err := h.orm.BridgeTypes(ctx, offset, healthCheckPageSize)
+		if err != nil {
+			h.eng.Warnw("Failed to load bridges for health checks", "err", err)
+			return
+		}
+		all = append(all, bts...)
+		if offset+healthCheckPageSize >= count {
+			break
+		}
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(maxConcurrentHealthChecks)
+	for _, bt := range all {
+		bt := bt
+		eg.Go(func() error {
+			h.Check(ctx, bt)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+
+	// forget deleted bridges
+	exists := map[BridgeName]bool{}
+	for _, bt := range all {
+		exists[bt.Name] = true
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for name := range h.health {
+		if !exists[name] {
+			delete(h.health, name)
+			delete(h.next, name)
+			h.eng.ClearHealthCond(name.String())
+		}
+	}
+}
+
+// Check checks the health of the endpoints of bt.
+func (h *HealthChecker) Check(ctx context.Context, bt BridgeType) {
+	client := h.client
+	var clientErr error
+	if bt.AuthScheme == AuthSchemeMTLS {
+		if h.auth == nil {
+			clientErr = pkgerrors.New("mtls auth is not available")
+		} else {
+			client, clientErr = h.auth.Client(bt, h.client)
+		}
+	}
+
+	endpoints := bt.Endpoints()
+	results := make([]EndpointHealth, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		if clientErr != nil {
+			results[i] = EndpointHealth{URL: endpoint, Status: EndpointStatusUnhealthy, CheckedAt: time.Now(), Error: clientErr.Error()}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, endpoint models.WebURL) {
+			defer wg.Done()
+			results[i] = checkEndpoint(ctx, client, endpoint)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	health := map[string]EndpointHealth{}
+	var unhealthy []string
+	for _, result := range results {
+		health[result.URL.String()] = result
+		if result.Status == EndpointStatusUnhealthy {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", result.URL.String(), result.Error))
+		}
+	}
+
+	h.mu.Lock()
+	h.health[bt.Name] = health
+	h.mu.Unlock()
+
+	if len(unhealthy) > 0 {
+		h.eng.SetHealthCond(bt.Name.String(), fmt.Errorf("%d of %d endpoints of bridge %q are unhealthy: %s", len(unhealthy), len(results), bt.Name, strings.Join(unhealthy, "; ")))
+	} else {
+		h.eng.ClearHealthCond(bt.Name.String())
+	}
+}
+
+func checkEndpoint(ctx context.Context, client *http.Client, endpoint models.WebURL) EndpointHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	result := EndpointHealth{URL: endpoint, Status: EndpointStatusUnhealthy}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		result.CheckedAt, result.Error = time.Now(), err.Error()
+		return result
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.CheckedAt = time.Now()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		result.Error = fmt.Sprintf("status code %d", resp.StatusCode)
+		return result
+	}
+	result.Status, result.Latency = EndpointStatusHealthy, result.CheckedAt.Sub(start)
+	return result
+}
+
+// Health returns the health of the endpoints of bt, in the order of
+// BridgeType.Endpoints. It is safe to call on a nil HealthChecker.
+func (h *HealthChecker) Health(bt BridgeType) []EndpointHealth {
+	var health map[string]EndpointHealth
+	if h != nil {
+		h.mu.RLock()
+		health = h.health[bt.Name]
+		h.mu.RUnlock()
+	}
+	var results []EndpointHealth
+	for _, endpoint := range bt.Endpoints() {
+		result, ok := health[endpoint.String()]
+		if !ok {
+			result = EndpointHealth{URL: endpoint, Status: EndpointStatusUnknown}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Endpoints returns the endpoints of bt in the order the bridge task should
+// try them: healthy endpoints and those not checked yet in the order of the
+// selection policy, followed by the unhealthy endpoints as a last resort.
+// It is safe to call on a nil HealthChecker.
+func (h *HealthChecker) Endpoints(bt BridgeType) []models.WebURL {
+	health := h.Health(bt)
+	var available, unhealthy []EndpointHealth
+	for _, result := range health {
+		if result.Status == EndpointStatusUnhealthy {
+			unhealthy = append(unhealthy, result)
+		} else {
+			available = append(available, result)
+		}
+	}
+
+	switch bt.SelectionPolicy {
+	case SelectionPolicyRoundRobin:
+		if h != nil && len(available) > 0 {
+			h.mu.Lock()
+			next := h.next[bt.Name] % len(available)
+			h.next[bt.Name] = next + 1
+			h.mu.Unlock()
+			available = append(append([]EndpointHealth{}, available[next:]...), available[:next]...)
+		}
+	case SelectionPolicyFastest:
+		// endpoints not checked yet have no latency and go last
+		sort.SliceStable(available, func(i, j int) bool {
+			li, lj := available[i].Latency, available[j].Latency
+			if li == 0 || lj == 0 {
+				return lj == 0 && li != 0
+			}
+			return li < lj
+		})
+	}
+
+	var endpoints []models.WebURL
+	for _, result := range append(available, unhealthy...) {
+		endpoints = append(endpoints, result.URL)
+	}
+	return endpoints
+}
@@ -0,0 +1,160 @@
+package bridges_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+func webURL(t *testing.T, s string) models.WebURL {
+	u, err := url.Parse(s)
+	require.NoError(t, err)
+	return models.WebURL(*u)
+}
+
+func TestHealthChecker(t *testing.T) {
+	t.Parallel()
+
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer healthy.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	bt := bridges.BridgeType{
+		Name:         "redundant",
+		URL:          webURL(t, failing.URL),
+		FallbackURLs: bridges.WebURLs{webURL(t, slow.URL), webURL(t, healthy.URL)},
+	}
+	rr := bt
+	rr.Name = "roundrobin"
+	rr.SelectionPolicy = bridges.SelectionPolicyRoundRobin
+	orm := mocks.NewORM(t)
+	orm.On("BridgeTypes", mock.Anything, 0, mock.Anything).Return([]bridges.BridgeType{bt, rr}, 2, nil).Maybe()
+	h := bridges.NewHealthChecker(orm, nil, http.DefaultClient, logger.TestLogger(t), 30*time.Second)
+
+	// endpoints are tried in order before they are checked
+	assert.Equal(t, bt.Endpoints(), h.Endpoints(bt))
+	for _, e := range h.Health(bt) {
+		assert.Equal(t, bridges.EndpointStatusUnknown, e.Status)
+	}
+
+	servicetest.Run(t, h)
+	h.Check(testutils.Context(t), bt)
+
+	health := h.Health(bt)
+	require.Len(t, health, 3)
+	assert.Equal(t, bridges.EndpointStatusUnhealthy, health[0].Status)
+	assert.Equal(t, "status code 502", health[0].Error)
+	assert.Equal(t, bridges.EndpointStatusHealthy, health[1].Status)
+	assert.GreaterOrEqual(t, health[1].Latency, 50*time.Millisecond)
+	assert.Equal(t, bridges.EndpointStatusHealthy, health[2].Status)
+
+	var reported error
+	for _, err := range h.HealthReport() {
+		if err != nil {
+			reported = err
+		}
+	}
+	require.Error(t, reported)
+	assert.Contains(t, reported.Error(), `1 of 3 endpoints of bridge "redundant" are unhealthy`)
+
+	t.Run("primary", func(t *testing.T) {
+		bt := bt
+		bt.SelectionPolicy = bridges.SelectionPolicyPrimary
+		assert.Equal(t, []models.WebURL{bt.FallbackURLs[0], bt.FallbackURLs[1], bt.URL}, h.Endpoints(bt))
+	})
+
+	t.Run("fastest", func(t *testing.T) {
+		bt := bt
+		bt.SelectionPolicy = bridges.SelectionPolicyFastest
+		assert.Equal(t, []models.WebURL{bt.FallbackURLs[1], bt.FallbackURLs[0], bt.URL}, h.Endpoints(bt))
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		h.Check(testutils.Context(t), rr)
+		assert.Equal(t, []models.WebURL{rr.FallbackURLs[0], rr.FallbackURLs[1], rr.URL}, h.Endpoints(rr))
+		assert.Equal(t, []models.WebURL{rr.FallbackURLs[1], rr.FallbackURLs[0], rr.URL}, h.Endpoints(rr))
+		assert.Equal(t, []models.WebURL{rr.FallbackURLs[0], rr.FallbackURLs[1], rr.URL}, h.Endpoints(rr))
+	})
+}
+
+func TestHealthChecker_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	const maxConcurrentHealthChecks = 10
+	var (
+		mu                    sync.Mutex
+		inFlight, maxInFlight int
+		requests              atomic.Int64
+	)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	var bts []bridges.BridgeType
+	for i := 0; i < 3*maxConcurrentHealthChecks; i++ {
+		bts = append(bts, bridges.BridgeType{Name: bridges.MustParseBridgeName("bridge" + strconv.Itoa(i)), URL: webURL(t, s.URL)})
+	}
+	orm := mocks.NewORM(t)
+	orm.On("BridgeTypes", mock.Anything, 0, mock.Anything).Return(bts, len(bts), nil).Maybe()
+	h := bridges.NewHealthChecker(orm, nil, http.DefaultClient, logger.TestLogger(t), 10*time.Millisecond)
+	servicetest.Run(t, h)
+
+	require.Eventually(t, func() bool {
+		return requests.Load() >= int64(len(bts))
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.LessOrEqual(t, maxInFlight, maxConcurrentHealthChecks)
+}
+
+func TestHealthChecker_Disabled(t *testing.T) {
+	t.Parallel()
+
+	bt := bridges.BridgeType{Name: "bridge", URL: webURL(t, "https://bridge.example.com")}
+	// bridges are never loaded for periodic health checks
+	orm := mocks.NewORM(t)
+	h := bridges.NewHealthChecker(orm, nil, http.DefaultClient, logger.TestLogger(t), 0)
+	servicetest.Run(t, h)
+
+	assert.Equal(t, bt.Endpoints(), h.Endpoints(bt))
+	for _, e := range h.Health(bt) {
+		assert.Equal(t, bridges.EndpointStatusUnknown, e.Status)
+	}
+}
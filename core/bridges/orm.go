@@ -124,8 +124,8 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, fallback_urls, selection_policy, auth_scheme, encrypted_auth, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :fallback_urls, :selection_policy, :auth_scheme, :encrypted_auth, now(), now())
 	RETURNING *;`
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
@@ -139,10 +139,19 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 	return pkgerrors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. The auth scheme and credentials
+// are taken from bt, see BridgeType.SetAuth, as are the fallback URLs and the
+// selection policy if they are omitted from btr.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	fallbackURLs, selectionPolicy := bt.FallbackURLs, bt.SelectionPolicy
+	if btr.FallbackURLs != nil {
+		fallbackURLs = btr.FallbackURLs
+	}
+	if btr.SelectionPolicy != "" {
+		selectionPolicy = btr.SelectionPolicy
+	}
+	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, fallback_urls = $4, selection_policy = $5, auth_scheme = $6, encrypted_auth = $7 WHERE name = $8 RETURNING *"
+	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, fallbackURLs, selectionPolicy, bt.AuthScheme, bt.EncryptedAuth, bt.Name)
 
 	return err
 }
@@ -124,6 +124,29 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
 
+	// fallback URLs and the selection policy are left unchanged if omitted
+	fallbacks := bridges.WebURLs{cltest.WebURL(t, "http://fallback.com")}
+	require.NoError(t, orm.UpdateBridgeType(ctx, firstBridge, &bridges.BridgeTypeRequest{
+		URL:             updateBridge.URL,
+		FallbackURLs:    fallbacks,
+		SelectionPolicy: bridges.SelectionPolicyRoundRobin,
+	}))
+	require.NoError(t, orm.UpdateBridgeType(ctx, firstBridge, updateBridge))
+	foundbridge, err = orm.FindBridge(ctx, "UniqueName")
+	require.NoError(t, err)
+	require.Equal(t, fallbacks, foundbridge.FallbackURLs)
+	require.Equal(t, bridges.SelectionPolicyRoundRobin, foundbridge.SelectionPolicy)
+
+	require.NoError(t, orm.UpdateBridgeType(ctx, firstBridge, &bridges.BridgeTypeRequest{
+		URL:             updateBridge.URL,
+		FallbackURLs:    bridges.WebURLs{},
+		SelectionPolicy: bridges.SelectionPolicyPrimary,
+	}))
+	foundbridge, err = orm.FindBridge(ctx, "UniqueName")
+	require.NoError(t, err)
+	require.Empty(t, foundbridge.FallbackURLs)
+	require.Equal(t, bridges.SelectionPolicyPrimary, foundbridge.SelectionPolicy)
+
 	bs, count, err := orm.BridgeTypes(ctx, 0, 10)
 	require.NoError(t, err)
 	require.Equal(t, 1, count)
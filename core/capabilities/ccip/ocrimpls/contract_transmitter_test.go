@@ -558,6 +558,7 @@ type TestEvmConfig struct {
 	ResendAfterThreshold time.Duration
 	BumpThreshold        uint64
 	MaxQueued            uint64
+	PriorityMaxWait      time.Duration
 	Enabled              bool
 	Threshold            uint32
 	MinAttempts          uint32
@@ -581,7 +582,7 @@ func (e *TestEvmConfig) BlockEmissionIdleWarningThreshold() time.Duration {
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
-	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}}
+	return &transactionsConfig{e: e, autoPurge: &autoPurgeConfig{}, nonceGapRepair: &nonceGapRepairConfig{}}
 }
 
 func (e *TestEvmConfig) NonceAutoSync() bool { return true }
@@ -618,6 +619,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Remote() evmconfig.RemoteEstimator {
+	return &TestRemoteEstimatorConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -670,19 +675,28 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestRemoteEstimatorConfig struct {
+	evmconfig.RemoteEstimator
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
-	e         *TestEvmConfig
-	autoPurge evmconfig.AutoPurgeConfig
+	e              *TestEvmConfig
+	autoPurge      evmconfig.AutoPurgeConfig
+	nonceGapRepair evmconfig.NonceGapRepairConfig
 }
 
 func (*transactionsConfig) ForwardersEnabled() bool                { return false }
 func (t *transactionsConfig) MaxInFlight() uint32                  { return t.e.MaxInFlight }
 func (t *transactionsConfig) MaxQueued() uint64                    { return t.e.MaxQueued }
+func (t *transactionsConfig) PriorityMaxWait() time.Duration       { return t.e.PriorityMaxWait }
 func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.ReaperInterval }
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) NonceGapRepair() evmconfig.NonceGapRepairConfig {
+	return t.nonceGapRepair
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -690,6 +704,12 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type nonceGapRepairConfig struct {
+	evmconfig.NonceGapRepairConfig
+}
+
+func (n *nonceGapRepairConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
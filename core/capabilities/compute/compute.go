@@ -203,6 +203,9 @@ func (c *Compute) createFetcher(workflowID, workflowExecutionID string) func(req
 		if err := validation.ValidateWorkflowOrExecutionID(workflowExecutionID); err != nil {
 			return nil, fmt.Errorf("workflow execution ID %q is invalid: %w", workflowExecutionID, err)
 		}
+		if c.outgoingConnectorHandler == nil {
+			return nil, errors.New("fetch is not available without a gateway connector")
+		}
 
 		messageID := strings.Join([]string{
 			workflowID,
@@ -0,0 +1,76 @@
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+package croncap
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Config struct {
+	// Cron expression with an optional leading seconds field, e.g. '0 */5 * * * *',
+	// or a descriptor, e.g. '@hourly'.
+	Schedule string `json:"schedule" yaml:"schedule" mapstructure:"schedule"`
+
+	// IANA time zone the schedule is evaluated in, e.g. 'America/New_York'. Defaults
+	// to UTC.
+	Timezone *string `json:"timezone,omitempty" yaml:"timezone,omitempty" mapstructure:"timezone,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *Config) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if _, ok := raw["schedule"]; raw != nil && !ok {
+		return fmt.Errorf("field schedule in Config: required")
+	}
+	type Plain Config
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	if len(plain.Schedule) < 1 {
+		return fmt.Errorf("field %s length: must be >= %d", "schedule", 1)
+	}
+	*j = Config(plain)
+	return nil
+}
+
+type Payload struct {
+	// Time the trigger actually fired at, in RFC 3339 format.
+	ActualExecutionTime string `json:"actualExecutionTime" yaml:"actualExecutionTime" mapstructure:"actualExecutionTime"`
+
+	// Time the trigger was scheduled to fire at, in RFC 3339 format.
+	ScheduledExecutionTime string `json:"scheduledExecutionTime" yaml:"scheduledExecutionTime" mapstructure:"scheduledExecutionTime"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *Payload) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if _, ok := raw["actualExecutionTime"]; raw != nil && !ok {
+		return fmt.Errorf("field actualExecutionTime in Payload: required")
+	}
+	if _, ok := raw["scheduledExecutionTime"]; raw != nil && !ok {
+		return fmt.Errorf("field scheduledExecutionTime in Payload: required")
+	}
+	type Plain Payload
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	*j = Payload(plain)
+	return nil
+}
+
+type Trigger struct {
+	// Config corresponds to the JSON schema field "Config".
+	Config *Config `json:"Config,omitempty" yaml:"Config,omitempty" mapstructure:"Config,omitempty"`
+
+	// Outputs corresponds to the JSON schema field "Outputs".
+	Outputs *Payload `json:"Outputs,omitempty" yaml:"Outputs,omitempty" mapstructure:"Outputs,omitempty"`
+}
@@ -0,0 +1,17 @@
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+package croncaptest
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+)
+
+// Trigger registers a new capability mock with the runner
+func Trigger(runner *testutils.Runner, fn func() (croncap.Payload, error)) *testutils.TriggerMock[croncap.Payload] {
+	mock := testutils.MockTrigger[croncap.Payload]("cron-trigger@1.0.0", fn)
+	runner.MockCapability("cron-trigger@1.0.0", nil, mock)
+	return mock
+}
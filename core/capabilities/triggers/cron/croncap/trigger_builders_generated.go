@@ -0,0 +1,77 @@
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+package croncap
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+func (cfg Config) New(w *sdk.WorkflowSpecFactory) PayloadCap {
+	ref := "trigger"
+	def := sdk.StepDefinition{
+		ID: "cron-trigger@1.0.0", Ref: ref,
+		Inputs: sdk.StepInputs{},
+		Config: map[string]any{
+			"schedule": cfg.Schedule,
+			"timezone": cfg.Timezone,
+		},
+		CapabilityType: capabilities.CapabilityTypeTrigger,
+	}
+
+	step := sdk.Step[Payload]{Definition: def}
+	return PayloadCapFromStep(w, step)
+}
+
+type PayloadCap interface {
+	sdk.CapDefinition[Payload]
+	ActualExecutionTime() sdk.CapDefinition[string]
+	ScheduledExecutionTime() sdk.CapDefinition[string]
+	private()
+}
+
+// PayloadCapFromStep should only be called from generated code to assure type safety
+func PayloadCapFromStep(w *sdk.WorkflowSpecFactory, step sdk.Step[Payload]) PayloadCap {
+	raw := step.AddTo(w)
+	return &payload{CapDefinition: raw}
+}
+
+type payload struct {
+	sdk.CapDefinition[Payload]
+}
+
+func (*payload) private() {}
+func (c *payload) ActualExecutionTime() sdk.CapDefinition[string] {
+	return sdk.AccessField[Payload, string](c.CapDefinition, "actualExecutionTime")
+}
+func (c *payload) ScheduledExecutionTime() sdk.CapDefinition[string] {
+	return sdk.AccessField[Payload, string](c.CapDefinition, "scheduledExecutionTime")
+}
+
+func NewPayloadFromFields(
+	actualExecutionTime sdk.CapDefinition[string],
+	scheduledExecutionTime sdk.CapDefinition[string]) PayloadCap {
+	return &simplePayload{
+		CapDefinition: sdk.ComponentCapDefinition[Payload]{
+			"actualExecutionTime":    actualExecutionTime.Ref(),
+			"scheduledExecutionTime": scheduledExecutionTime.Ref(),
+		},
+		actualExecutionTime:    actualExecutionTime,
+		scheduledExecutionTime: scheduledExecutionTime,
+	}
+}
+
+type simplePayload struct {
+	sdk.CapDefinition[Payload]
+	actualExecutionTime    sdk.CapDefinition[string]
+	scheduledExecutionTime sdk.CapDefinition[string]
+}
+
+func (c *simplePayload) ActualExecutionTime() sdk.CapDefinition[string] {
+	return c.actualExecutionTime
+}
+func (c *simplePayload) ScheduledExecutionTime() sdk.CapDefinition[string] {
+	return c.scheduledExecutionTime
+}
+
+func (c *simplePayload) private() {}
@@ -0,0 +1,292 @@
+package cron
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const ID = "cron-trigger@1.0.0"
+
+const defaultSendChannelBufferSize = 1000
+
+var cronTriggerInfo = capabilities.MustNewCapabilityInfo(
+	ID,
+	capabilities.CapabilityTypeTrigger,
+	"A trigger that starts a workflow execution on a cron schedule.",
+)
+
+// parser accepts standard cron expressions with an optional leading seconds field, and descriptors like @hourly.
+var parser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// TriggerService schedules the cron triggers of all registered workflows.
+//
+// The last scheduled time fired by each trigger is persisted before its event is
+// sent, and a trigger never fires at or before that time again, so that no
+// scheduled time is fired twice across restarts of the node or the workflow.
+// Fires missed while a trigger is not registered are skipped.
+type TriggerService struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+	capabilities.Validator[croncap.Config, struct{}, capabilities.TriggerResponse]
+	lggr     logger.Logger
+	clock    clockwork.Clock
+	registry core.CapabilitiesRegistry
+	kv       job.KVStore
+
+	mu       sync.Mutex
+	triggers map[string]*cronTrigger
+	stopCh   services.StopChan
+	wg       sync.WaitGroup
+}
+
+type cronTrigger struct {
+	ch       chan capabilities.TriggerResponse
+	schedule cron.Schedule
+	location *time.Location
+	// lastFired is the last scheduled time this trigger fired at, zero if it never fired.
+	lastFired time.Time
+	stopCh    services.StopChan
+	done      chan struct{}
+}
+
+var _ capabilities.TriggerCapability = (*TriggerService)(nil)
+var _ services.Service = &TriggerService{}
+
+// NewTriggerService creates a new cron trigger service, which records fired times in kv.
+// Scheduling will commence on calling .Start()
+func NewTriggerService(registry core.CapabilitiesRegistry, kv job.KVStore, clock clockwork.Clock, lggr logger.Logger) *TriggerService {
+	return &TriggerService{
+		CapabilityInfo: cronTriggerInfo,
+		Validator:      capabilities.NewValidator[croncap.Config, struct{}, capabilities.TriggerResponse](capabilities.ValidatorArgs{Info: cronTriggerInfo}),
+		lggr:           lggr.Named("CronTriggerService"),
+		clock:          clock,
+		registry:       registry,
+		kv:             kv,
+		triggers:       map[string]*cronTrigger{},
+		stopCh:         make(services.StopChan),
+	}
+}
+
+func (s *TriggerService) Info(ctx context.Context) (capabilities.CapabilityInfo, error) {
+	return s.CapabilityInfo, nil
+}
+
+// RegisterTrigger starts scheduling a new trigger, after the last time it fired if it already did.
+func (s *TriggerService) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	if req.Config == nil {
+		return nil, errors.New("config is required to register a cron trigger")
+	}
+	reqConfig, err := s.ValidateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	schedule, location, err := parseConfig(*reqConfig)
+	if err != nil {
+		return nil, err
+	}
+	lastFired, err := s.lastFired(ctx, req.TriggerID)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &cronTrigger{
+		ch:        make(chan capabilities.TriggerResponse, defaultSendChannelBufferSize),
+		schedule:  schedule,
+		location:  location,
+		lastFired: lastFired,
+		stopCh:    make(services.StopChan),
+		done:      make(chan struct{}),
+	}
+	ok := s.IfNotStopped(func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, exists := s.triggers[req.TriggerID]; exists {
+			err = fmt.Errorf("triggerId %s already registered", req.TriggerID)
+			return
+		}
+		s.triggers[req.TriggerID] = t
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(req.TriggerID, req.Metadata.WorkflowID, t)
+		}()
+	})
+	if !ok {
+		return nil, errors.New("cannot register trigger since CronTriggerService has been stopped")
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.lggr.Infow("RegisterTrigger", "triggerId", req.TriggerID, "workflowID", req.Metadata.WorkflowID, "schedule", reqConfig.Schedule, "lastFired", lastFired)
+	return t.ch, nil
+}
+
+func (s *TriggerService) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	s.mu.Lock()
+	t, ok := s.triggers[req.TriggerID]
+	delete(s.triggers, req.TriggerID)
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", req.TriggerID)
+	}
+
+	close(t.stopCh)
+	<-t.done
+	close(t.ch)
+	s.lggr.Infow("UnregisterTrigger", "triggerId", req.TriggerID, "workflowID", req.Metadata.WorkflowID)
+	return nil
+}
+
+// run fires the trigger at each scheduled time until it is unregistered or the service is stopped.
+func (s *TriggerService) run(triggerID string, workflowID string, t *cronTrigger) {
+	defer close(t.done)
+	ctx, cancel := s.stopCh.Ctx(context.Background())
+	defer cancel()
+	ctx, cancel = t.stopCh.Ctx(ctx)
+	defer cancel()
+	lggr := s.lggr.With("triggerId", triggerID, "workflowID", workflowID)
+
+	for {
+		after := s.clock.Now()
+		if t.lastFired.After(after) {
+			// the clock went backwards since the last fire
+			after = t.lastFired
+		}
+		scheduled := t.schedule.Next(after.In(t.location))
+		if scheduled.IsZero() {
+			lggr.Warn("Cron schedule has no next time to fire at")
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.clock.After(scheduled.Sub(s.clock.Now())):
+		}
+
+		// persist the fire before emitting it, so that it is never emitted twice
+		if err := s.kv.Store(ctx, kvKey(triggerID), []byte(scheduled.Format(time.RFC3339Nano))); err != nil {
+			lggr.Errorw("Skipping cron trigger fire which could not be recorded", "scheduledExecutionTime", scheduled, "err", err)
+			t.lastFired = scheduled
+			continue
+		}
+		t.lastFired = scheduled
+
+		resp := createTriggerResponse(triggerID, scheduled, s.clock.Now().In(t.location))
+		select {
+		case <-ctx.Done():
+			return
+		case t.ch <- resp:
+			lggr.Debugw("Cron trigger fired", "scheduledExecutionTime", scheduled)
+		}
+	}
+}
+
+// lastFired returns the last scheduled time the trigger fired at, or the zero time if it never fired.
+func (s *TriggerService) lastFired(ctx context.Context, triggerID string) (time.Time, error) {
+	b, err := s.kv.Get(ctx, kvKey(triggerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get last fire of trigger %s: %w", triggerID, err)
+	}
+	lastFired, err := time.Parse(time.RFC3339Nano, string(b))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid last fire of trigger %s: %w", triggerID, err)
+	}
+	return lastFired, nil
+}
+
+func (s *TriggerService) Start(ctx context.Context) error {
+	if err := s.registry.Add(ctx, s); err != nil {
+		return err
+	}
+	return s.StartOnce("CronTriggerService", func() error {
+		s.lggr.Info("Starting CronTriggerService")
+		return nil
+	})
+}
+
+// Close stops scheduling all triggers, and closes their channels.
+func (s *TriggerService) Close() error {
+	return s.StopOnce("CronTriggerService", func() error {
+		s.lggr.Info("Stopping CronTriggerService")
+		close(s.stopCh)
+		s.wg.Wait()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for id, t := range s.triggers {
+			close(t.ch)
+			delete(s.triggers, id)
+		}
+		return nil
+	})
+}
+
+func (s *TriggerService) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *TriggerService) Name() string {
+	return s.lggr.Name()
+}
+
+// parseConfig parses the schedule of the trigger config, and loads its time zone, UTC by default.
+func parseConfig(config croncap.Config) (cron.Schedule, *time.Location, error) {
+	if strings.HasPrefix(config.Schedule, "CRON_TZ=") || strings.HasPrefix(config.Schedule, "TZ=") {
+		return nil, nil, errors.New("cron schedule must not specify a time zone, use the timezone config instead")
+	}
+	schedule, err := parser.Parse(config.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cron schedule %q: %w", config.Schedule, err)
+	}
+	location := time.UTC
+	if config.Timezone != nil && *config.Timezone != "" {
+		if location, err = time.LoadLocation(*config.Timezone); err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone %q: %w", *config.Timezone, err)
+		}
+	}
+	return schedule, location, nil
+}
+
+func kvKey(triggerID string) string {
+	return "cron-trigger/" + triggerID + "/last-fired"
+}
+
+// createTriggerResponse creates the response of a fire, identified by the trigger and its scheduled time.
+func createTriggerResponse(triggerID string, scheduled time.Time, actual time.Time) capabilities.TriggerResponse {
+	wrappedPayload, err := values.WrapMap(croncap.Payload{
+		ScheduledExecutionTime: scheduled.Format(time.RFC3339Nano),
+		ActualExecutionTime:    actual.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error wrapping trigger event: %s", err),
+		}
+	}
+	return capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: ID,
+			ID:          fmt.Sprintf("%s@%d", triggerID, scheduled.UnixNano()),
+			Outputs:     wrappedPayload,
+		},
+	}
+}
@@ -0,0 +1,188 @@
+package cron
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	registrymock "github.com/smartcontractkit/chainlink-common/pkg/types/core/mocks"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/croncap"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	triggerID  = "<trigger-ID>"
+	workflowID = "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
+)
+
+type memKVStore struct {
+	mu       sync.Mutex
+	vals     map[string][]byte
+	storeErr error
+}
+
+func (kv *memKVStore) Store(ctx context.Context, key string, val []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.storeErr != nil {
+		return kv.storeErr
+	}
+	kv.vals[key] = val
+	return nil
+}
+
+func (kv *memKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	val, ok := kv.vals[key]
+	if !ok {
+		return nil, fmt.Errorf("failed to get value by key: %s: %w", key, sql.ErrNoRows)
+	}
+	return val, nil
+}
+
+func newTriggerService(t *testing.T, kv *memKVStore, clock clockwork.Clock) *TriggerService {
+	registry := registrymock.NewCapabilitiesRegistry(t)
+	registry.On("Add", mock.Anything, mock.Anything).Return(nil)
+	s := NewTriggerService(registry, kv, clock, logger.TestLogger(t))
+	require.NoError(t, s.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+	return s
+}
+
+func registrationRequest(t *testing.T, config map[string]any) capabilities.TriggerRegistrationRequest {
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.TriggerRegistrationRequest{
+		TriggerID: triggerID,
+		Metadata:  capabilities.RequestMetadata{WorkflowID: workflowID},
+		Config:    cfg,
+	}
+}
+
+// fire advances the clock to the next scheduled time of the only registered trigger, and returns its response.
+func fire(t *testing.T, clock clockwork.FakeClock, ch <-chan capabilities.TriggerResponse, d time.Duration) croncap.Payload {
+	clock.BlockUntil(1)
+	clock.Advance(d)
+	var resp capabilities.TriggerResponse
+	select {
+	case resp = <-ch:
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for trigger event")
+	}
+	require.NoError(t, resp.Err)
+	assert.Equal(t, ID, resp.Event.TriggerType)
+
+	var payload croncap.Payload
+	require.NoError(t, resp.Event.Outputs.UnwrapTo(&payload))
+	assert.Equal(t, fmt.Sprintf("%s@%d", triggerID, mustParse(t, payload.ScheduledExecutionTime).UnixNano()), resp.Event.ID)
+	return payload
+}
+
+func mustParse(t *testing.T, s string) time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, s)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestTriggerService(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("fires in the configured timezone", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		clock := clockwork.NewFakeClockAt(start)
+		kv := &memKVStore{vals: map[string][]byte{}}
+		s := newTriggerService(t, kv, clock)
+
+		req := registrationRequest(t, map[string]any{"schedule": "0 0 9 * * *", "timezone": "America/New_York"})
+		ch, err := s.RegisterTrigger(ctx, req)
+		require.NoError(t, err)
+
+		payload := fire(t, clock, ch, time.Hour+time.Second)
+		assert.Equal(t, "2024-10-01T09:00:00-04:00", payload.ScheduledExecutionTime)
+		assert.Equal(t, "2024-10-01T09:00:01-04:00", payload.ActualExecutionTime)
+
+		payload = fire(t, clock, ch, 24*time.Hour)
+		assert.Equal(t, "2024-10-02T09:00:00-04:00", payload.ScheduledExecutionTime)
+
+		require.NoError(t, s.UnregisterTrigger(ctx, req))
+		_, open := <-ch
+		assert.False(t, open)
+		require.ErrorContains(t, s.UnregisterTrigger(ctx, req), "not registered")
+	})
+
+	t.Run("does not fire twice across restarts", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		clock := clockwork.NewFakeClockAt(start)
+		kv := &memKVStore{vals: map[string][]byte{}}
+		req := registrationRequest(t, map[string]any{"schedule": "*/10 * * * * *"})
+
+		s := newTriggerService(t, kv, clock)
+		ch, err := s.RegisterTrigger(ctx, req)
+		require.NoError(t, err)
+		payload := fire(t, clock, ch, 10*time.Second)
+		assert.Equal(t, "2024-10-01T12:00:10Z", payload.ScheduledExecutionTime)
+		require.NoError(t, s.UnregisterTrigger(ctx, req))
+
+		// the clock of the restarted node is behind the last fire
+		clock = clockwork.NewFakeClockAt(start)
+		s = newTriggerService(t, kv, clock)
+		ch, err = s.RegisterTrigger(ctx, req)
+		require.NoError(t, err)
+		_, err = s.RegisterTrigger(ctx, req)
+		require.ErrorContains(t, err, "already registered")
+		payload = fire(t, clock, ch, 20*time.Second)
+		assert.Equal(t, "2024-10-01T12:00:20Z", payload.ScheduledExecutionTime)
+	})
+
+	t.Run("skips fires which could not be recorded", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		clock := clockwork.NewFakeClockAt(start)
+		kv := &memKVStore{vals: map[string][]byte{}, storeErr: errors.New("connection refused")}
+		s := newTriggerService(t, kv, clock)
+
+		ch, err := s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "@every 1m"}))
+		require.NoError(t, err)
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+
+		clock.BlockUntil(1)
+		kv.mu.Lock()
+		kv.storeErr = nil
+		kv.mu.Unlock()
+		payload := fire(t, clock, ch, time.Minute)
+		assert.Equal(t, "2024-10-01T12:02:00Z", payload.ScheduledExecutionTime)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		s := newTriggerService(t, &memKVStore{vals: map[string][]byte{}}, clockwork.NewFakeClockAt(start))
+
+		for _, tc := range []struct {
+			config map[string]any
+			errMsg string
+		}{
+			{map[string]any{}, "schedule"},
+			{map[string]any{"schedule": "every minute"}, `invalid cron schedule "every minute"`},
+			{map[string]any{"schedule": "CRON_TZ=UTC * * * * *"}, "use the timezone config instead"},
+			{map[string]any{"schedule": "* * * * *", "timezone": "Mars/Olympus_Mons"}, `invalid timezone "Mars/Olympus_Mons"`},
+		} {
+			_, err := s.RegisterTrigger(ctx, registrationRequest(t, tc.config))
+			require.ErrorContains(t, err, tc.errMsg)
+		}
+	})
+}
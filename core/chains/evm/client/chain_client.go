@@ -2,12 +2,15 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"reflect"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
@@ -33,6 +36,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeScores returns a map of node Name->node score
+	// It is nil, if the node selection mode does not rely on scores
+	NodeScores() map[string]float64
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -87,6 +93,9 @@ type Client interface {
 	HeaderByHash(ctx context.Context, h common.Hash) (*types.Header, error)
 
 	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	// CallContractWithQuorum - executes call on all alive RPCs at the same block and returns the result only if at
+	// least quorum of them agree on it. Quorum of 1 or lower is equivalent to CallContract.
+	CallContractWithQuorum(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int) ([]byte, error)
 	PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error)
 
 	IsL2() bool
@@ -119,6 +128,7 @@ func NewChainClient(
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	hedgedReads commonclient.HedgedReadsConfig,
 	chainType chaintype.ChainType,
 ) Client {
 	chainFamily := "EVM"
@@ -131,6 +141,7 @@ func NewChainClient(
 		chainID,
 		chainFamily,
 		deathDeclarationDelay,
+		hedgedReads,
 	)
 
 	classifySendError := func(tx *types.Transaction, err error) commonclient.SendTxReturnCode {
@@ -170,12 +181,44 @@ func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blo
 // Note: some chains (e.g Astar) have custom finality requests, so even when FinalityTagEnabled=true, finality tag
 // might not be properly handled and returned results might have weaker finality guarantees. It's highly recommended
 // to use HeadTracker to identify latest finalized block.
+// If hedged reads are enabled, the batch might be sent to two RPCs. Thus, it must only contain read requests.
 func (c *chainClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	r, err := c.multiNode.SelectRPC()
+	if !c.multiNode.HedgedReadsEnabled() {
+		r, err := c.multiNode.SelectRPC()
+		if err != nil {
+			return err
+		}
+		return r.BatchCallContext(ctx, b)
+	}
+
+	result, err := commonclient.DoHedgedRead(ctx, c.multiNode, func(ctx context.Context, r *RPCClient) ([]rpc.BatchElem, error) {
+		// each RPC must unmarshal into its own copy of results to avoid data races between hedged requests
+		batch := copyBatchElems(b)
+		return batch, r.BatchCallContext(ctx, batch)
+	})
 	if err != nil {
 		return err
 	}
-	return r.BatchCallContext(ctx, b)
+	for i := range b {
+		b[i].Error = result[i].Error
+		if b[i].Result != result[i].Result {
+			reflect.ValueOf(b[i].Result).Elem().Set(reflect.ValueOf(result[i].Result).Elem())
+		}
+	}
+	return nil
+}
+
+// copyBatchElems - returns copy of the batch with newly allocated results. Results that are not pointers are not
+// copied, as they can not be used to unmarshal a response into.
+func copyBatchElems(b []rpc.BatchElem) []rpc.BatchElem {
+	batch := make([]rpc.BatchElem, len(b))
+	for i, elem := range b {
+		batch[i] = rpc.BatchElem{Method: elem.Method, Args: elem.Args, Result: elem.Result}
+		if t := reflect.TypeOf(elem.Result); t != nil && t.Kind() == reflect.Pointer && !reflect.ValueOf(elem.Result).IsNil() {
+			batch[i].Result = reflect.New(t.Elem()).Interface()
+		}
+	}
+	return batch
 }
 
 // Similar to BatchCallContext, ensure the provided BatchElem slice is passed through
@@ -250,11 +293,26 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
+	return commonclient.DoHedgedRead(ctx, c.multiNode, func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// CallContractWithQuorum - resolves blockNumber (latest if nil) to a block hash using the selected RPC, and executes
+// the call at that hash on all alive RPCs. Pinning the call to the block hash ensures that RPCs on a different fork or
+// lagging behind can not agree with the majority.
+func (c *chainClient) CallContractWithQuorum(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int) ([]byte, error) {
+	if quorum <= 1 {
+		return c.CallContract(ctx, msg, blockNumber)
+	}
+
+	head, err := c.HeadByNumber(ctx, blockNumber)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to fetch block to execute quorum call at: %w", err)
 	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return commonclient.DoQuorumRead(ctx, c.multiNode, quorum, func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.CallContractAtHash(ctx, msg, head.Hash)
+	}, hexutil.Encode)
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
@@ -298,11 +356,9 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return r.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.FilterEvents(ctx, q)
+	return commonclient.DoHedgedRead(ctx, c.multiNode, func(ctx context.Context, r *RPCClient) ([]types.Log, error) {
+		return r.FilterEvents(ctx, q)
+	})
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
@@ -322,19 +378,15 @@ func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *typ
 }
 
 func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.BlockByHash(ctx, h)
+	return commonclient.DoHedgedRead(ctx, c.multiNode, func(ctx context.Context, r *RPCClient) (*evmtypes.Head, error) {
+		return r.BlockByHash(ctx, h)
+	})
 }
 
 func (c *chainClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.BlockByNumber(ctx, n)
+	return commonclient.DoHedgedRead(ctx, c.multiNode, func(ctx context.Context, r *RPCClient) (*evmtypes.Head, error) {
+		return r.BlockByNumber(ctx, n)
+	})
 }
 
 func (c *chainClient) IsL2() bool {
@@ -361,6 +413,16 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+// HealthReport - reports the client as unhealthy, if there are no live RPCs or RPCs disagreed on results of
+// quorum reads.
+func (c *chainClient) HealthReport() map[string]error {
+	return c.multiNode.HealthReport()
+}
+
+func (c *chainClient) NodeScores() map[string]float64 {
+	return c.multiNode.NodeScores()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
@@ -786,6 +786,41 @@ func TestEthClient_BatchCallContext(t *testing.T) {
 			require.Equal(t, elem.Error.Error(), rpcError.Error())
 		}
 	})
+
+	t.Run("batch results are unmarshalled into the given results", func(t *testing.T) {
+		var first, second string
+		b := []rpc.BatchElem{
+			{Method: "eth_call", Args: []interface{}{0}, Result: &first},
+			{Method: "eth_call", Args: []interface{}{1}, Result: &second},
+		}
+
+		wsURL := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+			switch method {
+			case "eth_subscribe":
+				resp.Result = `"0x00"`
+				resp.Notify = headResult
+				return
+			case "eth_unsubscribe":
+				resp.Result = "true"
+				return
+			}
+			require.Equal(t, "eth_call", method)
+			resp.Result = fmt.Sprintf(`"0x0%d"`, params.Array()[0].Int())
+			return
+		}).WSURL().String()
+
+		ethClient := mustNewChainClient(t, wsURL)
+		err := ethClient.Dial(tests.Context(t))
+		require.NoError(t, err)
+
+		err = ethClient.BatchCallContext(tests.Context(t), b)
+		require.NoError(t, err)
+		for _, elem := range b {
+			require.NoError(t, elem.Error)
+		}
+		assert.Equal(t, "0x00", first)
+		assert.Equal(t, "0x01", second)
+	})
 }
 
 func TestEthClient_ErroringClient(t *testing.T) {
@@ -44,6 +44,9 @@ func NewClientConfigs(
 	noNewFinalizedHeadsThreshold time.Duration,
 	finalizedBlockPollInterval time.Duration,
 	newHeadsPollInterval time.Duration,
+	hedgedReadsEnabled *bool,
+	hedgedReadsPercentile *uint16,
+	hedgedReadsMinDelay time.Duration,
 ) (commonclient.ChainConfig, evmconfig.NodePool, []*toml.Node, error) {
 	nodes, err := parseNodeConfigs(nodeCfgs)
 	if err != nil {
@@ -60,6 +63,9 @@ func NewClientConfigs(
 		DeathDeclarationDelay:      commonconfig.MustNewDuration(deathDeclarationDelay),
 		FinalizedBlockPollInterval: commonconfig.MustNewDuration(finalizedBlockPollInterval),
 		NewHeadsPollInterval:       commonconfig.MustNewDuration(newHeadsPollInterval),
+		HedgedReadsEnabled:         hedgedReadsEnabled,
+		HedgedReadsPercentile:      hedgedReadsPercentile,
+		HedgedReadsMinDelay:        commonconfig.MustNewDuration(hedgedReadsMinDelay),
 	}
 	nodePoolCfg := &evmconfig.NodePoolConfig{C: nodePool}
 	chainConfig := &evmconfig.EVMConfig{
@@ -38,10 +38,13 @@ func TestClientConfigBuilder(t *testing.T) {
 	finalityTagEnabled := ptr(true)
 	noNewHeadsThreshold := time.Second
 	newHeadsPollInterval := 0 * time.Second
+	hedgedReadsEnabled := ptr(true)
+	hedgedReadsPercentile := ptr(uint16(90))
+	hedgedReadsMinDelay := 50 * time.Millisecond
 	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, chainTypeStr, nodeConfigs,
 		pollFailureThreshold, pollInterval, syncThreshold, nodeIsSyncingEnabled, noNewHeadsThreshold, finalityDepth,
 		finalityTagEnabled, finalizedBlockOffset, enforceRepeatableRead, deathDeclarationDelay, noNewFinalizedBlocksThreshold,
-		pollInterval, newHeadsPollInterval)
+		pollInterval, newHeadsPollInterval, hedgedReadsEnabled, hedgedReadsPercentile, hedgedReadsMinDelay)
 	require.NoError(t, err)
 
 	// Validate node pool configs
@@ -55,6 +58,9 @@ func TestClientConfigBuilder(t *testing.T) {
 	require.Equal(t, deathDeclarationDelay, nodePool.DeathDeclarationDelay())
 	require.Equal(t, pollInterval, nodePool.FinalizedBlockPollInterval())
 	require.Equal(t, newHeadsPollInterval, nodePool.NewHeadsPollInterval())
+	require.Equal(t, *hedgedReadsEnabled, nodePool.HedgedReadsEnabled())
+	require.Equal(t, *hedgedReadsPercentile, nodePool.HedgedReadsPercentile())
+	require.Equal(t, hedgedReadsMinDelay, nodePool.HedgedReadsMinDelay())
 
 	// Validate node configs
 	require.Equal(t, *nodeConfigs[0].Name, *nodes[0].Name)
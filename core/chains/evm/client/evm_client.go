@@ -35,8 +35,14 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 		}
 	}
 
+	hedgedReads := commonclient.HedgedReadsConfig{
+		Enabled:    cfg.HedgedReadsEnabled(),
+		Percentile: cfg.HedgedReadsPercentile(),
+		MinDelay:   cfg.HedgedReadsMinDelay(),
+	}
+
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), hedgedReads, chainType), nil
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
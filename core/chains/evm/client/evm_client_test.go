@@ -30,6 +30,9 @@ func TestNewEvmClient(t *testing.T) {
 	noNewFinalizedBlocksThreshold := time.Second * 5
 	finalizedBlockPollInterval := time.Second * 4
 	newHeadsPollInterval := time.Second * 4
+	hedgedReadsEnabled := ptr(true)
+	hedgedReadsPercentile := ptr(uint16(95))
+	hedgedReadsMinDelay := time.Millisecond * 100
 	nodeConfigs := []client.NodeConfig{
 		{
 			Name:    ptr("foo"),
@@ -42,7 +45,7 @@ func TestNewEvmClient(t *testing.T) {
 	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, chainTypeStr, nodeConfigs,
 		pollFailureThreshold, pollInterval, syncThreshold, nodeIsSyncingEnabled, noNewHeadsThreshold, finalityDepth,
 		finalityTagEnabled, finalizedBlockOffset, enforceRepeatableRead, deathDeclarationDelay, noNewFinalizedBlocksThreshold,
-		finalizedBlockPollInterval, newHeadsPollInterval)
+		finalizedBlockPollInterval, newHeadsPollInterval, hedgedReadsEnabled, hedgedReadsPercentile, hedgedReadsMinDelay)
 	require.NoError(t, err)
 
 	client, err := client.NewEvmClient(nodePool, chainCfg, nil, logger.Test(t), testutils.FixtureChainID, nodes, chaintype.ChainType(chainTypeStr))
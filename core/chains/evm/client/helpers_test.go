@@ -92,6 +92,9 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	HedgedReadsEnabledVal          bool
+	NodeHedgedReadsPercentile      uint16
+	NodeHedgedReadsMinDelay        time.Duration
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -126,6 +129,18 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) HedgedReadsEnabled() bool {
+	return tc.HedgedReadsEnabledVal
+}
+
+func (tc TestNodePoolConfig) HedgedReadsPercentile() uint16 {
+	return tc.NodeHedgedReadsPercentile
+}
+
+func (tc TestNodePoolConfig) HedgedReadsMinDelay() time.Duration {
+	return tc.NodeHedgedReadsMinDelay
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -168,7 +183,7 @@ func NewChainClientWithTestNode(
 	}
 
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, commonclient.HedgedReadsConfig{}, "")
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -182,7 +197,7 @@ func NewChainClientWithEmptyNode(
 ) Client {
 	lggr := logger.Test(t)
 
-	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, commonclient.HedgedReadsConfig{}, "")
 	t.Cleanup(c.Close)
 	return c
 }
@@ -206,7 +221,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, commonclient.HedgedReadsConfig{}, "")
 	t.Cleanup(c.Close)
 	return c
 }
@@ -430,6 +430,67 @@ func (_c *Client_CallContract_Call) RunAndReturn(run func(context.Context, ether
 	return _c
 }
 
+// CallContractWithQuorum provides a mock function with given fields: ctx, msg, blockNumber, quorum
+func (_m *Client) CallContractWithQuorum(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int) ([]byte, error) {
+	ret := _m.Called(ctx, msg, blockNumber, quorum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallContractWithQuorum")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ethereum.CallMsg, *big.Int, int) ([]byte, error)); ok {
+		return rf(ctx, msg, blockNumber, quorum)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ethereum.CallMsg, *big.Int, int) []byte); ok {
+		r0 = rf(ctx, msg, blockNumber, quorum)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ethereum.CallMsg, *big.Int, int) error); ok {
+		r1 = rf(ctx, msg, blockNumber, quorum)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_CallContractWithQuorum_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallContractWithQuorum'
+type Client_CallContractWithQuorum_Call struct {
+	*mock.Call
+}
+
+// CallContractWithQuorum is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg ethereum.CallMsg
+//   - blockNumber *big.Int
+//   - quorum int
+func (_e *Client_Expecter) CallContractWithQuorum(ctx interface{}, msg interface{}, blockNumber interface{}, quorum interface{}) *Client_CallContractWithQuorum_Call {
+	return &Client_CallContractWithQuorum_Call{Call: _e.mock.On("CallContractWithQuorum", ctx, msg, blockNumber, quorum)}
+}
+
+func (_c *Client_CallContractWithQuorum_Call) Run(run func(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int)) *Client_CallContractWithQuorum_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ethereum.CallMsg), args[2].(*big.Int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Client_CallContractWithQuorum_Call) Return(_a0 []byte, _a1 error) *Client_CallContractWithQuorum_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_CallContractWithQuorum_Call) RunAndReturn(run func(context.Context, ethereum.CallMsg, *big.Int, int) ([]byte, error)) *Client_CallContractWithQuorum_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckTxValidity provides a mock function with given fields: ctx, from, to, data
 func (_m *Client) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *client.SendError {
 	ret := _m.Called(ctx, from, to, data)
@@ -1299,6 +1360,53 @@ func (_c *Client_LatestFinalizedBlock_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// NodeScores provides a mock function with given fields:
+func (_m *Client) NodeScores() map[string]float64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeScores")
+	}
+
+	var r0 map[string]float64
+	if rf, ok := ret.Get(0).(func() map[string]float64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+
+	return r0
+}
+
+// Client_NodeScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeScores'
+type Client_NodeScores_Call struct {
+	*mock.Call
+}
+
+// NodeScores is a helper method to define mock.On call
+func (_e *Client_Expecter) NodeScores() *Client_NodeScores_Call {
+	return &Client_NodeScores_Call{Call: _e.mock.On("NodeScores")}
+}
+
+func (_c *Client_NodeScores_Call) Run(run func()) *Client_NodeScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_NodeScores_Call) Return(_a0 map[string]float64) *Client_NodeScores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_NodeScores_Call) RunAndReturn(run func() map[string]float64) *Client_NodeScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NodeStates provides a mock function with given fields:
 func (_m *Client) NodeStates() map[string]string {
 	ret := _m.Called()
@@ -197,6 +197,11 @@ func (nc *NullClient) CallContract(ctx context.Context, msg ethereum.CallMsg, bl
 	return nil, nil
 }
 
+func (nc *NullClient) CallContractWithQuorum(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int) ([]byte, error) {
+	nc.lggr.Debug("CallContractWithQuorum")
+	return nil, nil
+}
+
 func (nc *NullClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
 	nc.lggr.Debug("PendingCallContract")
 	return nil, nil
@@ -223,6 +228,9 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[string]string { return nil }
 
+// NodeScores implements evmclient.Client
+func (nc *NullClient) NodeScores() map[string]float64 { return nil }
+
 func (nc *NullClient) IsL2() bool {
 	nc.lggr.Debug("IsL2")
 	return false
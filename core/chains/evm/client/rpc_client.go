@@ -1001,6 +1001,37 @@ func (r *RPCClient) CallContract(ctx context.Context, msg interface{}, blockNumb
 	return
 }
 
+// CallContractAtHash - executes call at the block with the given hash. RPC fails the call, if the block is not part of
+// its canonical chain.
+func (r *RPCClient) CallContractAtHash(ctx context.Context, msg interface{}, blockHash common.Hash) (val []byte, err error) {
+	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
+	defer cancel()
+	lggr := r.newRqLggr().With("callMsg", msg, "blockHash", blockHash)
+	message := msg.(ethereum.CallMsg)
+
+	lggr.Debug("RPC call: evmclient.Client#CallContractAtHash")
+	start := time.Now()
+	var hex hexutil.Bytes
+	blockArg := rpc.BlockNumberOrHashWithHash(blockHash, true)
+	if http != nil {
+		err = http.rpc.CallContext(ctx, &hex, "eth_call", ToBackwardCompatibleCallArg(message), blockArg)
+		err = r.wrapHTTP(err)
+	} else {
+		err = ws.rpc.CallContext(ctx, &hex, "eth_call", ToBackwardCompatibleCallArg(message), blockArg)
+		err = r.wrapWS(err)
+	}
+	if err == nil {
+		val = hex
+	}
+	duration := time.Since(start)
+
+	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContractAtHash",
+		"val", val,
+	)
+
+	return
+}
+
 func (r *RPCClient) PendingCallContract(ctx context.Context, msg interface{}) (val []byte, err error) {
 	ctx, cancel, ws, http := r.makeLiveQueryCtxAndSafeGetClients(ctx, r.largePayloadRPCTimeout)
 	defer cancel()
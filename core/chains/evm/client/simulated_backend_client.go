@@ -435,6 +435,11 @@ func (c *SimulatedBackendClient) CallContract(ctx context.Context, msg ethereum.
 	return res, nil
 }
 
+// CallContractWithQuorum calls a contract. Simulated backend is a single node, so quorum is ignored.
+func (c *SimulatedBackendClient) CallContractWithQuorum(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int, quorum int) ([]byte, error) {
+	return c.CallContract(ctx, msg, blockNumber)
+}
+
 func (c *SimulatedBackendClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
 	// Expected error is
 	// type JsonError struct {
@@ -527,6 +532,9 @@ func (c *SimulatedBackendClient) Backend() *backends.SimulatedBackend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeScores implements evmclient.Client
+func (c *SimulatedBackendClient) NodeScores() map[string]float64 { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
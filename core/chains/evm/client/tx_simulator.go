@@ -2,8 +2,12 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -29,6 +33,94 @@ func simulateTransactionDefault(ctx context.Context, client simulatorClient, msg
 	return client.CallContext(ctx, &result, "eth_estimateGas", toCallArg(msg), "pending")
 }
 
+type callSimulatorClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+}
+
+// CallSimulationResult is the outcome of a call executed against the latest state
+type CallSimulationResult struct {
+	// Reverted is true, if the call was rejected by the RPC, e.g. because the execution reverted
+	Reverted bool
+	// GasUsed is the gas estimated by the RPC, it is only set if the call did not revert
+	GasUsed uint64
+	// ReturnData is only set if the call did not revert
+	ReturnData []byte
+	// Error is the error message returned by the RPC
+	Error string
+	// RevertData is the raw data returned by the reverted call, if any
+	RevertData []byte
+	// RevertReason is the revert data decoded as Error(string), Panic(uint256) or one of the custom errors of the
+	// provided ABI
+	RevertReason string
+}
+
+// SimulateCall executes msg with eth_call and eth_estimateGas against the latest state to determine whether the
+// transaction would succeed if broadcast now. errorsABI is optional and used to decode custom errors.
+// Errors returned by the RPC for the call are part of the result, only transport errors are returned.
+func SimulateCall(ctx context.Context, client callSimulatorClient, msg ethereum.CallMsg, errorsABI *abi.ABI) (result CallSimulationResult, err error) {
+	result.ReturnData, err = client.CallContract(ctx, msg, nil)
+	if err == nil {
+		result.GasUsed, err = client.EstimateGas(ctx, msg)
+	}
+	if err == nil {
+		return result, nil
+	}
+
+	jsonErr, extractErr := ExtractRPCError(err)
+	if extractErr != nil {
+		return result, err
+	}
+	result = CallSimulationResult{Reverted: true, Error: jsonErr.Message}
+	result.RevertData = revertDataFromRPCError(jsonErr)
+	if len(result.RevertData) > 0 {
+		result.RevertReason = DecodeRevertData(result.RevertData, errorsABI)
+	}
+	return result, nil
+}
+
+// revertDataFromRPCError extracts revert data from the data field of the error. Some RPCs prefix the data with "Reverted".
+func revertDataFromRPCError(jsonErr *JsonError) []byte {
+	data, ok := jsonErr.Data.(string)
+	if !ok {
+		return nil
+	}
+	data = strings.TrimSpace(strings.TrimPrefix(data, "Reverted"))
+	b, err := hexutil.Decode(data)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// DecodeRevertData decodes data returned by a reverted call as Error(string), Panic(uint256) or as one of the custom
+// errors defined in errorsABI. An empty string is returned if the data cannot be decoded.
+func DecodeRevertData(data []byte, errorsABI *abi.ABI) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if errorsABI == nil || len(data) < 4 {
+		return ""
+	}
+	abiErr, err := errorsABI.ErrorByID([4]byte(data[:4]))
+	if err != nil {
+		return ""
+	}
+	unpacked, err := abiErr.Unpack(data)
+	if err != nil {
+		return abiErr.Name
+	}
+	values, ok := unpacked.([]interface{})
+	if !ok {
+		return abiErr.Name
+	}
+	args := make([]string, len(values))
+	for i, v := range values {
+		args[i] = fmt.Sprint(v)
+	}
+	return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(args, ", "))
+}
+
 func toCallArg(msg ethereum.CallMsg) interface{} {
 	arg := map[string]interface{}{
 		"from": msg.From,
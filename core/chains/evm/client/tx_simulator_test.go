@@ -1,9 +1,16 @@
 package client_test
 
 import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
@@ -111,3 +118,81 @@ func TestSimulateTx_Default(t *testing.T) {
 		require.Equal(t, false, sendErr.IsTerminallyStuckConfigError(nil))
 	})
 }
+
+type callSimulatorClient struct {
+	callResult []byte
+	callErr    error
+	gas        uint64
+	gasErr     error
+}
+
+func (c *callSimulatorClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return c.callResult, c.callErr
+}
+
+func (c *callSimulatorClient) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return c.gas, c.gasErr
+}
+
+func TestSimulateCall(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	toAddress := testutils.NewAddress()
+	msg := ethereum.CallMsg{From: testutils.NewAddress(), To: &toAddress, Data: []byte{1, 2, 3}}
+	errorsABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`))
+	require.NoError(t, err)
+	abiErr := errorsABI.Errors["InsufficientBalance"]
+	args, err := abiErr.Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	require.NoError(t, err)
+	insufficientBalance := append(abiErr.ID[:4], args...)
+
+	t.Run("returns gas used and return data if call succeeds", func(t *testing.T) {
+		result, err := client.SimulateCall(ctx, &callSimulatorClient{callResult: []byte{4, 5}, gas: 21000}, msg, nil)
+		require.NoError(t, err)
+		assert.False(t, result.Reverted)
+		assert.Equal(t, uint64(21000), result.GasUsed)
+		assert.Equal(t, []byte{4, 5}, result.ReturnData)
+	})
+	t.Run("returns revert reason of Error(string)", func(t *testing.T) {
+		revertData := hexutil.MustDecode("0x08c379a0000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000047465737400000000000000000000000000000000000000000000000000000000")
+		result, err := client.SimulateCall(ctx, &callSimulatorClient{
+			callErr: &client.JsonError{Code: 3, Message: "execution reverted: test", Data: hexutil.Encode(revertData)},
+		}, msg, nil)
+		require.NoError(t, err)
+		assert.True(t, result.Reverted)
+		assert.Equal(t, "execution reverted: test", result.Error)
+		assert.Equal(t, revertData, result.RevertData)
+		assert.Equal(t, "test", result.RevertReason)
+	})
+	t.Run("decodes custom error using the ABI", func(t *testing.T) {
+		result, err := client.SimulateCall(ctx, &callSimulatorClient{
+			callErr: &client.JsonError{Code: -32015, Message: "VM execution error.", Data: "Reverted " + hexutil.Encode(insufficientBalance)},
+		}, msg, &errorsABI)
+		require.NoError(t, err)
+		assert.True(t, result.Reverted)
+		assert.Equal(t, "InsufficientBalance(1, 2)", result.RevertReason)
+
+		// custom error can't be decoded without ABI
+		result, err = client.SimulateCall(ctx, &callSimulatorClient{
+			callErr: &client.JsonError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(insufficientBalance)},
+		}, msg, nil)
+		require.NoError(t, err)
+		assert.True(t, result.Reverted)
+		assert.Equal(t, insufficientBalance, result.RevertData)
+		assert.Empty(t, result.RevertReason)
+	})
+	t.Run("returns RPC error of gas estimation", func(t *testing.T) {
+		result, err := client.SimulateCall(ctx, &callSimulatorClient{
+			gasErr: &client.JsonError{Code: -32000, Message: "gas required exceeds allowance"},
+		}, msg, nil)
+		require.NoError(t, err)
+		assert.True(t, result.Reverted)
+		assert.Equal(t, "gas required exceeds allowance", result.Error)
+		assert.Empty(t, result.RevertData)
+	})
+	t.Run("returns transport errors", func(t *testing.T) {
+		_, err := client.SimulateCall(ctx, &callSimulatorClient{callErr: errors.New("connection refused")}, msg, nil)
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"net/url"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -43,6 +44,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Remote() RemoteEstimator {
+	return &remoteEstimatorConfig{c: g.c.Remote}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -216,3 +221,23 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type remoteEstimatorConfig struct {
+	c toml.RemoteEstimator
+}
+
+func (r *remoteEstimatorConfig) URL() *url.URL {
+	return r.c.URL.URL()
+}
+
+func (r *remoteEstimatorConfig) PollPeriod() time.Duration {
+	return r.c.PollPeriod.Duration()
+}
+
+func (r *remoteEstimatorConfig) Timeout() time.Duration {
+	return r.c.Timeout.Duration()
+}
+
+func (r *remoteEstimatorConfig) FallbackMode() string {
+	return *r.c.FallbackMode
+}
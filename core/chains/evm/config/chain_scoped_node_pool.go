@@ -51,3 +51,15 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) HedgedReadsEnabled() bool {
+	return *n.C.HedgedReadsEnabled
+}
+
+func (n *NodePoolConfig) HedgedReadsPercentile() uint16 {
+	return *n.C.HedgedReadsPercentile
+}
+
+func (n *NodePoolConfig) HedgedReadsMinDelay() time.Duration {
+	return n.C.HedgedReadsMinDelay.Duration()
+}
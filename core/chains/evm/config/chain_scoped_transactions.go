@@ -35,10 +35,18 @@ func (t *transactionsConfig) MaxQueued() uint64 {
 	return uint64(*t.c.MaxQueued)
 }
 
+func (t *transactionsConfig) PriorityMaxWait() time.Duration {
+	return t.c.PriorityMaxWait.Duration()
+}
+
 func (t *transactionsConfig) AutoPurge() AutoPurgeConfig {
 	return &autoPurgeConfig{c: t.c.AutoPurge}
 }
 
+func (t *transactionsConfig) NonceGapRepair() NonceGapRepairConfig {
+	return &nonceGapRepairConfig{c: t.c.NonceGapRepair}
+}
+
 type autoPurgeConfig struct {
 	c toml.AutoPurgeConfig
 }
@@ -58,3 +66,19 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+type nonceGapRepairConfig struct {
+	c toml.NonceGapRepairConfig
+}
+
+func (n *nonceGapRepairConfig) Enabled() bool {
+	return *n.c.Enabled
+}
+
+func (n *nonceGapRepairConfig) Interval() time.Duration {
+	return n.c.Interval.Duration()
+}
+
+func (n *nonceGapRepairConfig) Mode() toml.NonceGapRepairMode {
+	return *n.c.Mode
+}
@@ -109,7 +109,9 @@ type Transactions interface {
 	ReaperThreshold() time.Duration
 	MaxInFlight() uint32
 	MaxQueued() uint64
+	PriorityMaxWait() time.Duration
 	AutoPurge() AutoPurgeConfig
+	NonceGapRepair() NonceGapRepairConfig
 }
 
 type AutoPurgeConfig interface {
@@ -119,9 +121,16 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type NonceGapRepairConfig interface {
+	Enabled() bool
+	Interval() time.Duration
+	Mode() toml.NonceGapRepairMode
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Remote() RemoteEstimator
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -174,6 +183,13 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type RemoteEstimator interface {
+	URL() *url.URL
+	PollPeriod() time.Duration
+	Timeout() time.Duration
+	FallbackMode() string
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -192,6 +208,9 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	HedgedReadsEnabled() bool
+	HedgedReadsPercentile() uint16
+	HedgedReadsMinDelay() time.Duration
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
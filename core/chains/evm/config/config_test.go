@@ -247,6 +247,17 @@ func TestChainScopedConfig_FeeHistory(t *testing.T) {
 	assert.Equal(t, 10*time.Second, u.CacheTimeout())
 }
 
+func TestChainScopedConfig_Remote(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	r := cfg.EVM().GasEstimator().Remote()
+	assert.Nil(t, r.URL())
+	assert.Equal(t, 10*time.Second, r.PollPeriod())
+	assert.Equal(t, time.Second, r.Timeout())
+	assert.Equal(t, "BlockHistory", r.FallbackMode())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
@@ -945,6 +945,53 @@ func (_c *GasEstimator_PriceMin_Call) RunAndReturn(run func() *assets.Wei) *GasE
 	return _c
 }
 
+// Remote provides a mock function with given fields:
+func (_m *GasEstimator) Remote() config.RemoteEstimator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remote")
+	}
+
+	var r0 config.RemoteEstimator
+	if rf, ok := ret.Get(0).(func() config.RemoteEstimator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.RemoteEstimator)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_Remote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remote'
+type GasEstimator_Remote_Call struct {
+	*mock.Call
+}
+
+// Remote is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) Remote() *GasEstimator_Remote_Call {
+	return &GasEstimator_Remote_Call{Call: _e.mock.On("Remote")}
+}
+
+func (_c *GasEstimator_Remote_Call) Run(run func()) *GasEstimator_Remote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_Remote_Call) Return(_a0 config.RemoteEstimator) *GasEstimator_Remote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_Remote_Call) RunAndReturn(run func() config.RemoteEstimator) *GasEstimator_Remote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TipCapDefault provides a mock function with given fields:
 func (_m *GasEstimator) TipCapDefault() *assets.Wei {
 	ret := _m.Called()
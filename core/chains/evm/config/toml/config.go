@@ -464,11 +464,13 @@ type Transactions struct {
 	ForwardersEnabled    *bool
 	MaxInFlight          *uint32
 	MaxQueued            *uint32
+	PriorityMaxWait      *commonconfig.Duration
 	ReaperInterval       *commonconfig.Duration
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge      AutoPurgeConfig      `toml:",omitempty"`
+	NonceGapRepair NonceGapRepairConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -481,6 +483,9 @@ func (t *Transactions) setFrom(f *Transactions) {
 	if v := f.MaxQueued; v != nil {
 		t.MaxQueued = v
 	}
+	if v := f.PriorityMaxWait; v != nil {
+		t.PriorityMaxWait = v
+	}
 	if v := f.ReaperInterval; v != nil {
 		t.ReaperInterval = v
 	}
@@ -491,6 +496,7 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.NonceGapRepair.setFrom(&f.NonceGapRepair)
 }
 
 type AutoPurgeConfig struct {
@@ -515,6 +521,45 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type NonceGapRepairMode string
+
+const (
+	NonceGapRepairModeSelfTransfer NonceGapRepairMode = "SelfTransfer"
+	NonceGapRepairModeResequence   NonceGapRepairMode = "Resequence"
+)
+
+type NonceGapRepairConfig struct {
+	Enabled  *bool
+	Interval *commonconfig.Duration
+	Mode     *NonceGapRepairMode
+}
+
+func (n *NonceGapRepairConfig) setFrom(f *NonceGapRepairConfig) {
+	if v := f.Enabled; v != nil {
+		n.Enabled = v
+	}
+	if v := f.Interval; v != nil {
+		n.Interval = v
+	}
+	if v := f.Mode; v != nil {
+		n.Mode = v
+	}
+}
+
+func (n *NonceGapRepairConfig) ValidateConfig() (err error) {
+	if n.Mode != nil {
+		switch *n.Mode {
+		case NonceGapRepairModeSelfTransfer, NonceGapRepairModeResequence:
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *n.Mode, Msg: fmt.Sprintf("must be one of %s or %s", NonceGapRepairModeSelfTransfer, NonceGapRepairModeResequence)})
+		}
+	}
+	if n.Enabled != nil && *n.Enabled && n.Interval != nil && n.Interval.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Interval", Value: n.Interval, Msg: "must be greater than 0 if nonce gap repair is enabled"})
+	}
+	return
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -589,6 +634,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Remote       RemoteEstimator       `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -626,6 +672,21 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Remote" {
+		if e.Remote.URL == nil || e.Remote.URL.IsZero() {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Remote.URL", Msg: "must be set with Remote Mode"})
+		} else if s := e.Remote.URL.Scheme; s != "http" && s != "https" {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Remote.URL", Value: s, Msg: "must be http or https"})
+		}
+		if e.Remote.PollPeriod != nil && e.Remote.PollPeriod.Duration() <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Remote.PollPeriod", Value: e.Remote.PollPeriod,
+				Msg: "must be greater than 0"})
+		}
+		if e.Remote.FallbackMode != nil && *e.Remote.FallbackMode == "Remote" {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Remote.FallbackMode", Value: *e.Remote.FallbackMode,
+				Msg: "must not be Remote"})
+		}
+	}
 
 	return
 }
@@ -685,6 +746,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Remote.setFrom(&f.Remote)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -758,6 +820,28 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type RemoteEstimator struct {
+	URL          *commonconfig.URL
+	PollPeriod   *commonconfig.Duration
+	Timeout      *commonconfig.Duration
+	FallbackMode *string
+}
+
+func (r *RemoteEstimator) setFrom(f *RemoteEstimator) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.PollPeriod; v != nil {
+		r.PollPeriod = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+	if v := f.FallbackMode; v != nil {
+		r.FallbackMode = v
+	}
+}
+
 type DAOracle struct {
 	OracleType             DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -937,6 +1021,9 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	HedgedReadsEnabled         *bool
+	HedgedReadsPercentile      *uint16
+	HedgedReadsMinDelay        *commonconfig.Duration
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -974,6 +1061,18 @@ func (p *NodePool) setFrom(f *NodePool) {
 		p.NewHeadsPollInterval = v
 	}
 
+	if v := f.HedgedReadsEnabled; v != nil {
+		p.HedgedReadsEnabled = v
+	}
+
+	if v := f.HedgedReadsPercentile; v != nil {
+		p.HedgedReadsPercentile = v
+	}
+
+	if v := f.HedgedReadsMinDelay; v != nil {
+		p.HedgedReadsMinDelay = v
+	}
+
 	p.Errors.setFrom(&f.Errors)
 }
 
@@ -988,6 +1087,10 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 				Msg: "must be greater than 0"})
 		}
 	}
+	if p.HedgedReadsPercentile != nil && *p.HedgedReadsPercentile > 100 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HedgedReadsPercentile", Value: *p.HedgedReadsPercentile,
+			Msg: "must be less than or equal to 100"})
+	}
 	return
 }
 
@@ -1,6 +1,7 @@
 package gas
 
 import (
+	"net/url"
 	"testing"
 	"time"
 
@@ -121,6 +122,24 @@ func (m *MockBlockHistoryConfig) TransactionPercentile() uint16 {
 	return m.TransactionPercentileF
 }
 
+type MockRemoteEstimatorConfig struct {
+	URLF        *url.URL
+	PollPeriodF time.Duration
+	TimeoutF    time.Duration
+}
+
+func (m *MockRemoteEstimatorConfig) URL() *url.URL {
+	return m.URLF
+}
+
+func (m *MockRemoteEstimatorConfig) PollPeriod() time.Duration {
+	return m.PollPeriodF
+}
+
+func (m *MockRemoteEstimatorConfig) Timeout() time.Duration {
+	return m.TimeoutF
+}
+
 type MockGasEstimatorConfig struct {
 	EIP1559DynamicFeesF bool
 	BumpPercentF        uint16
@@ -197,3 +216,8 @@ func (m *MockGasEstimatorConfig) Mode() string {
 func (m *MockGasEstimatorConfig) EstimateLimit() bool {
 	return m.EstimateLimitF
 }
+
+func (r *RemoteEstimator) PricesFetched() bool {
+	_, err := r.getPrices()
+	return err == nil
+}
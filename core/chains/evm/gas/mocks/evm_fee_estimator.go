@@ -147,6 +147,74 @@ func (_c *EvmFeeEstimator_Close_Call) RunAndReturn(run func() error) *EvmFeeEsti
 	return _c
 }
 
+// GetBlobFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address) (gas.EvmFee, uint64, error) {
+	ret := _m.Called(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 gas.EvmFee
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address) (gas.EvmFee, uint64, error)); ok {
+		return rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address) gas.EvmFee); ok {
+		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	} else {
+		r0 = ret.Get(0).(gas.EvmFee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address) uint64); ok {
+		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address) error); ok {
+		r2 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmFeeEstimator_GetBlobFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlobFee'
+type EvmFeeEstimator_GetBlobFee_Call struct {
+	*mock.Call
+}
+
+// GetBlobFee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calldata []byte
+//   - feeLimit uint64
+//   - maxFeePrice *assets.Wei
+//   - fromAddress *common.Address
+//   - toAddress *common.Address
+func (_e *EvmFeeEstimator_Expecter) GetBlobFee(ctx interface{}, calldata interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}) *EvmFeeEstimator_GetBlobFee_Call {
+	return &EvmFeeEstimator_GetBlobFee_Call{Call: _e.mock.On("GetBlobFee", ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress)}
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Run(run func(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(uint64), args[3].(*assets.Wei), args[4].(*common.Address), args[5].(*common.Address))
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) Return(fee gas.EvmFee, estimatedFeeLimit uint64, err error) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(fee, estimatedFeeLimit, err)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetBlobFee_Call) RunAndReturn(run func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address) (gas.EvmFee, uint64, error)) *EvmFeeEstimator_GetBlobFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
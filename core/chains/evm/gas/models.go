@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 
@@ -28,6 +30,13 @@ import (
 // EstimateGasBuffer is a multiplier applied to estimated gas when the EstimateLimit feature is enabled
 const EstimateGasBuffer = float32(1.15)
 
+// BlobFeeCapMultiplier is applied to the current blob base fee to tolerate blob base fee spikes while the tx is pending
+const BlobFeeCapMultiplier = 2
+
+// BlobBumpMultiplier is the minimum multiplier applied to all fees of a blob transaction when it is bumped, as
+// required by the blob pool of the clients for replacement transactions
+const BlobBumpMultiplier = 2
+
 // EvmFeeEstimator provides a unified interface that wraps EvmEstimator and can determine if legacy or dynamic fee estimation should be used
 type EvmFeeEstimator interface {
 	services.Service
@@ -36,6 +45,9 @@ type EvmFeeEstimator interface {
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
+	// GetBlobFee returns an initial estimated fee for an EIP-4844 blob transaction: a dynamic fee and a max fee per blob gas
+	// derived from the excess blob gas of the latest head
+	GetBlobFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
@@ -89,8 +101,17 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			return nil, fmt.Errorf("failed to initialize L1 oracle: %w", err)
 		}
 	}
-	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
+	newEstimator, err := newEstimatorForMode(lggr, ethClient, chaintype, geCfg, l1Oracle, s)
+	if err != nil {
+		return nil, err
+	}
+	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+}
+
+// newEstimatorForMode returns a constructor of the EvmEstimator for the given estimator mode
+func newEstimatorForMode(lggr logger.Logger, ethClient feeEstimatorClient, chaintype chaintype.ChainType, geCfg evmconfig.GasEstimator, l1Oracle rollups.L1Oracle, mode string) (newEstimator func(logger.Logger) EvmEstimator, err error) {
+	bh := geCfg.BlockHistory()
+	switch mode {
 	case "Arbitrum":
 		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
 		if err != nil {
@@ -123,13 +144,25 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
 		}
 
+	case "Remote":
+		fallbackMode := geCfg.Remote().FallbackMode()
+		if fallbackMode == "Remote" {
+			return nil, fmt.Errorf("GasEstimator: Remote estimator can not use Remote as fallback mode")
+		}
+		newFallback, err := newEstimatorForMode(lggr, ethClient, chaintype, geCfg, l1Oracle, fallbackMode)
+		if err != nil {
+			return nil, err
+		}
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			return NewRemoteEstimator(l, geCfg, geCfg.Remote(), bh, ethClient.ConfiguredChainID(), newFallback(l), l1Oracle)
+		}
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+	return newEstimator, nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -179,9 +212,14 @@ var _ feetypes.Fee = (*EvmFee)(nil)
 type EvmFee struct {
 	GasPrice *assets.Wei
 	DynamicFee
+	// BlobFeeCap is the max fee per blob gas and is only set for EIP-4844 blob transactions
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s, BlobFeeCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap)
 }
 
@@ -197,6 +235,9 @@ type evmFeeEstimator struct {
 	EIP1559Enabled bool
 	geCfg          GasEstimatorConfig
 	ethClient      feeEstimatorClient
+
+	// blobBaseFee is derived from the excess blob gas of the latest head, nil if the chain does not support EIP-4844
+	blobBaseFee atomic.Pointer[assets.Wei]
 }
 
 var _ EvmFeeEstimator = (*evmFeeEstimator)(nil)
@@ -278,6 +319,14 @@ func (e *evmFeeEstimator) L1Oracle() rollups.L1Oracle {
 	return e.EvmEstimator.L1Oracle()
 }
 
+// OnNewLongestChain records the blob base fee of the head and passes the head to the wrapped estimator
+func (e *evmFeeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	if head != nil && head.ExcessBlobGas != nil {
+		e.blobBaseFee.Store(assets.NewWei(eip4844.CalcBlobFee(*head.ExcessBlobGas)))
+	}
+	e.EvmEstimator.OnNewLongestChain(ctx, head)
+}
+
 // GetFee returns an initial estimated gas price and gas limit for a transaction
 // The gas limit provided by the caller can be adjusted by gas estimation or for 2D fees
 func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error) {
@@ -304,6 +353,27 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 	return
 }
 
+// GetBlobFee returns an initial estimated fee and gas limit for an EIP-4844 blob transaction.
+// Blob transactions always use dynamic fees. The max fee per blob gas is the blob base fee of the latest head multiplied
+// by BlobFeeCapMultiplier.
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address) (fee EvmFee, estimatedFeeLimit uint64, err error) {
+	blobBaseFee := e.blobBaseFee.Load()
+	if blobBaseFee == nil {
+		err = pkgerrors.New("blob base fee is not available: no head with excess blob gas was received yet")
+		return
+	}
+	dynamicFee, err := e.EvmEstimator.GetDynamicFee(ctx, maxFeePrice)
+	if err != nil {
+		return
+	}
+	fee.GasFeeCap = dynamicFee.GasFeeCap
+	fee.GasTipCap = dynamicFee.GasTipCap
+	fee.BlobFeeCap = blobBaseFee.Mul(big.NewInt(BlobFeeCapMultiplier))
+
+	estimatedFeeLimit, err = e.estimateFeeLimit(ctx, feeLimit, calldata, fromAddress, toAddress)
+	return
+}
+
 func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
 	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	if err != nil {
@@ -330,6 +400,20 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	}
 
 	// bump fee based on what fee the tx has previously used (not based on config)
+	// bump blob original
+	if originalFee.BlobFeeCap != nil {
+		if !originalFee.ValidDynamic() {
+			err = pkgerrors.New("blob fee must be defined together with dynamic fee")
+			return
+		}
+		bumpedFee, err = e.bumpBlobFee(ctx, originalFee, maxFeePrice, attempts)
+		if err != nil {
+			return
+		}
+		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
+		return
+	}
+
 	// bump dynamic original
 	if originalFee.ValidDynamic() {
 		var bumpedDynamic DynamicFee
@@ -356,6 +440,31 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	return
 }
 
+// bumpBlobFee bumps the dynamic fee with the wrapped estimator and ensures that all the fees are bumped at least by
+// BlobBumpMultiplier, otherwise the replacement transaction is rejected by the blob pool.
+// The blob fee cap is the largest of the bumped original blob fee cap and the blob fee cap for the latest head.
+func (e *evmFeeEstimator) bumpBlobFee(ctx context.Context, originalFee EvmFee, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, err error) {
+	bumpedDynamic, err := e.EvmEstimator.BumpDynamicFee(ctx, originalFee.DynamicFee, maxFeePrice, attempts)
+	if err != nil {
+		return
+	}
+	multiplier := big.NewInt(BlobBumpMultiplier)
+	bumpedFee.GasTipCap = assets.WeiMax(bumpedDynamic.GasTipCap, originalFee.GasTipCap.Mul(multiplier))
+	bumpedFee.GasFeeCap = assets.WeiMax(bumpedDynamic.GasFeeCap, originalFee.GasFeeCap.Mul(multiplier))
+	bumpedFee.BlobFeeCap = originalFee.BlobFeeCap.Mul(multiplier)
+	if blobBaseFee := e.blobBaseFee.Load(); blobBaseFee != nil {
+		bumpedFee.BlobFeeCap = assets.WeiMax(bumpedFee.BlobFeeCap, blobBaseFee.Mul(big.NewInt(BlobFeeCapMultiplier)))
+	}
+
+	maxGasPrice := getMaxGasPrice(maxFeePrice, e.geCfg.PriceMax())
+	if bumpedFee.GasFeeCap.Cmp(maxGasPrice) > 0 {
+		err = fmt.Errorf("bumped blob tx fee cap of %s would exceed configured max gas price of %s (original fee: %s): %w",
+			bumpedFee.GasFeeCap.String(), maxGasPrice.String(), originalFee.String(), commonfee.ErrBumpFeeExceedsLimit)
+		return EvmFee{}, err
+	}
+	return bumpedFee, nil
+}
+
 func (e *evmFeeEstimator) estimateFeeLimit(ctx context.Context, feeLimit uint64, calldata []byte, fromAddress, toAddress *common.Address) (estimatedFeeLimit uint64, err error) {
 	// Use the feeLimit * LimitMultiplier as the provided gas limit since this multiplier is applied on top of the caller specified gas limit
 	providedGasLimit, err := commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
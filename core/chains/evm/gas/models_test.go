@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
 	rollupMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestWrappedEvmEstimator(t *testing.T) {
@@ -406,3 +408,84 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestWrappedEvmEstimator_BlobFee(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+
+	gasLimit := uint64(10)
+	dynamicFee := gas.DynamicFee{
+		GasFeeCap: assets.NewWeiI(20),
+		GasTipCap: assets.NewWeiI(1),
+	}
+	maxGasPrice := assets.NewWeiI(100)
+	geCfg := gas.NewMockGasConfig()
+	geCfg.LimitMultiplierF = 1
+	geCfg.PriceMaxF = maxGasPrice
+
+	newEstimator := func(t *testing.T) (gas.EvmFeeEstimator, *mocks.EvmEstimator) {
+		est := mocks.NewEvmEstimator(t)
+		est.On("OnNewLongestChain", mock.Anything, mock.Anything).Maybe()
+		return gas.NewEvmFeeEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, false, geCfg, nil), est
+	}
+	excessBlobGas := uint64(10_000_000)
+	blobFeeCap := assets.NewWei(eip4844.CalcBlobFee(excessBlobGas)).Mul(big.NewInt(gas.BlobFeeCapMultiplier))
+	newHead := func(excessBlobGas uint64) *evmtypes.Head {
+		h := testutils.Head(1)
+		h.ExcessBlobGas = &excessBlobGas
+		return h
+	}
+
+	t.Run("GetBlobFee fails if blob base fee is unknown", func(t *testing.T) {
+		estimator, _ := newEstimator(t)
+		_, _, err := estimator.GetBlobFee(ctx, nil, gasLimit, maxGasPrice, nil, nil)
+		require.ErrorContains(t, err, "blob base fee is not available")
+	})
+
+	t.Run("GetBlobFee returns dynamic fee and blob fee cap derived from the latest head", func(t *testing.T) {
+		estimator, est := newEstimator(t)
+		est.On("GetDynamicFee", mock.Anything, maxGasPrice).Return(dynamicFee, nil).Once()
+		estimator.OnNewLongestChain(ctx, newHead(excessBlobGas))
+		fee, limit, err := estimator.GetBlobFee(ctx, nil, gasLimit, maxGasPrice, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, limit)
+		assert.Nil(t, fee.GasPrice)
+		assert.True(t, dynamicFee.GasFeeCap.Equal(fee.GasFeeCap))
+		assert.True(t, dynamicFee.GasTipCap.Equal(fee.GasTipCap))
+		assert.Equal(t, blobFeeCap.String(), fee.BlobFeeCap.String())
+	})
+
+	t.Run("BumpFee bumps all fees of blob tx at least by the blob bump multiplier", func(t *testing.T) {
+		estimator, est := newEstimator(t)
+		original := gas.EvmFee{DynamicFee: dynamicFee, BlobFeeCap: assets.NewWeiI(10)}
+		est.On("BumpDynamicFee", mock.Anything, dynamicFee, maxGasPrice, mock.Anything).
+			Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(30), GasTipCap: assets.NewWeiI(5)}, nil).Once()
+		fee, limit, err := estimator.BumpFee(ctx, original, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gasLimit, limit)
+		assert.Equal(t, assets.NewWeiI(40).String(), fee.GasFeeCap.String())
+		assert.Equal(t, assets.NewWeiI(5).String(), fee.GasTipCap.String())
+		assert.Equal(t, assets.NewWeiI(20).String(), fee.BlobFeeCap.String())
+
+		// blob fee cap follows the blob base fee, if it increased more than the bump
+		est.On("BumpDynamicFee", mock.Anything, dynamicFee, maxGasPrice, mock.Anything).Return(dynamicFee, nil).Once()
+		estimator.OnNewLongestChain(ctx, newHead(excessBlobGas))
+		fee, _, err = estimator.BumpFee(ctx, original, gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, blobFeeCap.String(), fee.BlobFeeCap.String())
+	})
+
+	t.Run("BumpFee fails if bumped blob tx fee cap exceeds max gas price", func(t *testing.T) {
+		estimator, est := newEstimator(t)
+		original := gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(60), GasTipCap: assets.NewWeiI(1)}, BlobFeeCap: assets.NewWeiI(10)}
+		est.On("BumpDynamicFee", mock.Anything, original.DynamicFee, maxGasPrice, mock.Anything).Return(original.DynamicFee, nil).Once()
+		_, _, err := estimator.BumpFee(ctx, original, gasLimit, maxGasPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("BumpFee fails if blob fee is set without dynamic fee", func(t *testing.T) {
+		estimator, _ := newEstimator(t)
+		_, _, err := estimator.BumpFee(ctx, gas.EvmFee{GasPrice: assets.NewWeiI(10), BlobFeeCap: assets.NewWeiI(10)}, gasLimit, maxGasPrice, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,289 @@
+package gas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	promRemoteEstimatorFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_remote_estimator_fallbacks",
+		Help: "The total number of fee estimations that were served by the fallback estimator, because the remote oracle failed",
+	}, []string{"evmChainID"})
+)
+
+var _ EvmEstimator = (*RemoteEstimator)(nil)
+
+// remoteEstimatorMaxResponseSize is the maximum number of bytes read from the response of the remote oracle.
+const remoteEstimatorMaxResponseSize = 64 * 1024
+
+type remoteEstimatorConfig interface {
+	PriceMin() *assets.Wei
+	TipCapMin() *assets.Wei
+	bumpConfig
+}
+
+type remoteEstimatorOracleConfig interface {
+	URL() *url.URL
+	PollPeriod() time.Duration
+	Timeout() time.Duration
+}
+
+type remoteEstimatorBlockHistoryConfig interface {
+	EIP1559FeeCapBufferBlocks() uint16
+}
+
+// remoteEstimatorResponse is the response expected from the remote oracle.
+// Prices are either plain integers in wei or use unit suffixes, e.g. "10 gwei".
+type remoteEstimatorResponse struct {
+	GasPrice             *assets.Wei `json:"gasPrice"`
+	MaxFeePerGas         *assets.Wei `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *assets.Wei `json:"maxPriorityFeePerGas"`
+}
+
+// RemoteEstimator is an Estimator which uses prices provided by an external HTTP oracle.
+// The oracle is polled in the background with `GET <URL>?chainId=<chainID>` every pollPeriod. If the last poll failed or
+// did not respond within the configured timeout, estimations are delegated to the fallback estimator. The first poll
+// happens in the background after Start, so estimations are served by the fallback estimator until it succeeds.
+// Prices returned by the oracle are clamped by PriceMin, PriceMax and TipCapMin.
+type RemoteEstimator struct {
+	services.StateMachine
+
+	cfg        remoteEstimatorConfig
+	oracleCfg  remoteEstimatorOracleConfig
+	bhCfg      remoteEstimatorBlockHistoryConfig
+	chainID    *big.Int
+	httpClient *http.Client
+	fallback   EvmEstimator
+	pollPeriod time.Duration
+	lggr       logger.SugaredLogger
+
+	pricesMu  sync.RWMutex
+	prices    *remoteEstimatorResponse
+	pricesErr error
+
+	chStop services.StopChan
+	chDone chan struct{}
+
+	l1Oracle rollups.L1Oracle
+}
+
+// NewRemoteEstimator returns a new Estimator which uses prices from the remote oracle and falls back to the provided
+// estimator, if the oracle is not available.
+func NewRemoteEstimator(lggr logger.Logger, cfg remoteEstimatorConfig, oracleCfg remoteEstimatorOracleConfig, bhCfg remoteEstimatorBlockHistoryConfig, chainID *big.Int, fallback EvmEstimator, l1Oracle rollups.L1Oracle) *RemoteEstimator {
+	return &RemoteEstimator{
+		cfg:        cfg,
+		oracleCfg:  oracleCfg,
+		bhCfg:      bhCfg,
+		chainID:    chainID,
+		httpClient: &http.Client{},
+		fallback:   fallback,
+		pollPeriod: oracleCfg.PollPeriod(),
+		lggr:       logger.Sugared(logger.Named(lggr, "RemoteEstimator")),
+		pricesErr:  pkgerrors.New("prices not fetched yet"),
+
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+		l1Oracle: l1Oracle,
+	}
+}
+
+func (r *RemoteEstimator) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *RemoteEstimator) L1Oracle() rollups.L1Oracle {
+	return r.l1Oracle
+}
+
+func (r *RemoteEstimator) Start(ctx context.Context) error {
+	return r.StartOnce("RemoteEstimator", func() error {
+		if err := r.fallback.Start(ctx); err != nil {
+			return err
+		}
+		go r.run()
+		return nil
+	})
+}
+
+func (r *RemoteEstimator) Close() error {
+	return r.StopOnce("RemoteEstimator", func() error {
+		close(r.chStop)
+		<-r.chDone
+		return r.fallback.Close()
+	})
+}
+
+func (r *RemoteEstimator) run() {
+	defer close(r.chDone)
+
+	r.refreshPrices()
+
+	t := services.TickerConfig{
+		Initial:   r.pollPeriod,
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(r.pollPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-t.C:
+			r.refreshPrices()
+		}
+	}
+}
+
+// refreshPrices polls the oracle, and replaces the prices used by estimations with its response or error.
+func (r *RemoteEstimator) refreshPrices() {
+	ctx, cancel := r.chStop.NewCtx()
+	defer cancel()
+
+	prices, err := r.fetchPrices(ctx)
+	if err != nil {
+		r.lggr.Warnw("Failed to refresh prices from the remote oracle", "err", err)
+	} else {
+		r.lggr.Debugw("refreshPrices", "gasPrice", prices.GasPrice, "maxFeePerGas", prices.MaxFeePerGas, "maxPriorityFeePerGas", prices.MaxPriorityFeePerGas)
+	}
+
+	r.pricesMu.Lock()
+	defer r.pricesMu.Unlock()
+	r.prices, r.pricesErr = prices, err
+}
+
+// getPrices returns the prices of the last poll of the oracle, or its error.
+func (r *RemoteEstimator) getPrices() (*remoteEstimatorResponse, error) {
+	r.pricesMu.RLock()
+	defer r.pricesMu.RUnlock()
+	return r.prices, r.pricesErr
+}
+
+func (r *RemoteEstimator) HealthReport() map[string]error {
+	report := map[string]error{r.Name(): r.Healthy()}
+	services.CopyHealth(report, r.fallback.HealthReport())
+	return report
+}
+
+func (r *RemoteEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	r.fallback.OnNewLongestChain(ctx, head)
+}
+
+func (r *RemoteEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (*assets.Wei, uint64, error) {
+	resp, err := r.getPrices()
+	if err == nil && resp.GasPrice == nil {
+		err = pkgerrors.New("response does not contain gasPrice")
+	}
+	if err != nil {
+		r.onFallback("GetLegacyGas", err)
+		return r.fallback.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+	}
+
+	gasPrice := capGasPrice(assets.WeiMax(resp.GasPrice, r.cfg.PriceMin()), maxGasPriceWei, r.cfg.PriceMax())
+	r.lggr.Debugw("GetLegacyGas", "gasPrice", gasPrice, "oracleGasPrice", resp.GasPrice, "gasLimit", gasLimit)
+	return gasPrice, gasLimit, nil
+}
+
+func (r *RemoteEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	resp, err := r.getPrices()
+	if err == nil && resp.GasPrice == nil {
+		err = pkgerrors.New("response does not contain gasPrice")
+	}
+	if err != nil {
+		r.onFallback("BumpLegacyGas", err)
+		return r.fallback.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+	}
+
+	currentGasPrice := assets.WeiMax(resp.GasPrice, r.cfg.PriceMin())
+	bumpedGasPrice, err := BumpLegacyGasPriceOnly(r.cfg, r.lggr, currentGasPrice, originalGasPrice, maxGasPriceWei)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bumpedGasPrice, gasLimit, nil
+}
+
+func (r *RemoteEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	resp, err := r.getPrices()
+	if err == nil && (resp.MaxFeePerGas == nil || resp.MaxPriorityFeePerGas == nil) {
+		err = pkgerrors.New("response does not contain maxFeePerGas and maxPriorityFeePerGas")
+	}
+	if err != nil {
+		r.onFallback("GetDynamicFee", err)
+		return r.fallback.GetDynamicFee(ctx, maxGasPriceWei)
+	}
+
+	maxGasPrice := getMaxGasPrice(maxGasPriceWei, r.cfg.PriceMax())
+	fee.GasFeeCap = assets.WeiMin(assets.WeiMax(resp.MaxFeePerGas, r.cfg.PriceMin()), maxGasPrice)
+	fee.GasTipCap = assets.WeiMin(assets.WeiMax(resp.MaxPriorityFeePerGas, r.cfg.TipCapMin()), fee.GasFeeCap)
+	r.lggr.Debugw("GetDynamicFee", "fee", fee, "oracleMaxFeePerGas", resp.MaxFeePerGas, "oracleMaxPriorityFeePerGas", resp.MaxPriorityFeePerGas)
+	return fee, nil
+}
+
+func (r *RemoteEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	resp, err := r.getPrices()
+	if err == nil && resp.MaxPriorityFeePerGas == nil {
+		err = pkgerrors.New("response does not contain maxPriorityFeePerGas")
+	}
+	if err != nil {
+		r.onFallback("BumpDynamicFee", err)
+		return r.fallback.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+	}
+
+	currentTipCap := assets.WeiMax(resp.MaxPriorityFeePerGas, r.cfg.TipCapMin())
+	return BumpDynamicFeeOnly(r.cfg, r.bhCfg.EIP1559FeeCapBufferBlocks(), r.lggr, currentTipCap, nil, original, maxGasPriceWei)
+}
+
+func (r *RemoteEstimator) onFallback(method string, err error) {
+	promRemoteEstimatorFallbacks.WithLabelValues(r.chainID.String()).Inc()
+	r.lggr.Warnw("Prices from the remote oracle are not available, using fallback estimator", "method", method, "err", err)
+}
+
+func (r *RemoteEstimator) fetchPrices(ctx context.Context) (*remoteEstimatorResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.oracleCfg.Timeout())
+	defer cancel()
+
+	u := *r.oracleCfg.URL()
+	query := u.Query()
+	query.Set("chainId", r.chainID.String())
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make new request with context: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to remote oracle failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request failed with status %d", resp.StatusCode)
+	}
+
+	prices := new(remoteEstimatorResponse)
+	if err = json.NewDecoder(io.LimitReader(resp.Body, remoteEstimatorMaxResponseSize)).Decode(prices); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response into struct: %w", err)
+	}
+	return prices, nil
+}
@@ -0,0 +1,268 @@
+package gas_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+func newRemoteOracle(t *testing.T, handler http.HandlerFunc) *gas.MockRemoteEstimatorConfig {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return &gas.MockRemoteEstimatorConfig{URLF: u, PollPeriodF: time.Hour, TimeoutF: tests.TestInterval}
+}
+
+// requirePricesFetched waits for the first successful poll of the oracle, which happens in the background after Start.
+func requirePricesFetched(t *testing.T, o *gas.RemoteEstimator) {
+	require.Eventually(t, o.PricesFetched, tests.WaitTimeout(t), tests.TestInterval)
+}
+
+func respondWith(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, body)
+	}
+}
+
+func TestRemoteEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(100)
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint64 = 80000
+	chainID := big.NewInt(1337)
+
+	cfg := &gas.MockGasEstimatorConfig{
+		BumpPercentF:   10,
+		BumpMinF:       assets.NewWeiI(1),
+		PriceMinF:      assets.NewWeiI(10),
+		PriceMaxF:      assets.NewWeiI(80),
+		TipCapMinF:     assets.NewWeiI(5),
+		TipCapDefaultF: assets.NewWeiI(5),
+	}
+	bhCfg := &gas.MockBlockHistoryConfig{EIP1559FeeCapBufferBlocksF: 4}
+
+	newEstimator := func(t *testing.T, oracleCfg *gas.MockRemoteEstimatorConfig) (*gas.RemoteEstimator, *mocks.EvmEstimator) {
+		fallback := mocks.NewEvmEstimator(t)
+		fallback.On("Start", mock.Anything).Return(nil)
+		fallback.On("Close").Return(nil)
+		fallback.On("HealthReport").Return(map[string]error{}).Maybe()
+		o := gas.NewRemoteEstimator(logger.Test(t), cfg, oracleCfg, bhCfg, chainID, fallback, nil)
+		servicetest.RunHealthy(t, o)
+		return o, fallback
+	}
+
+	t.Run("GetLegacyGas queries the oracle with chain ID", func(t *testing.T) {
+		oracleCfg := newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "1337", r.URL.Query().Get("chainId"))
+			_, _ = fmt.Fprint(w, `{"gasPrice": "42"}`)
+		})
+		o, _ := newEstimator(t, oracleCfg)
+		requirePricesFetched(t, o)
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("GetLegacyGas uses the prices of the last poll", func(t *testing.T) {
+		var requests atomic.Int32
+		o, _ := newEstimator(t, newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			_, _ = fmt.Fprint(w, `{"gasPrice": "42"}`)
+		}))
+		requirePricesFetched(t, o)
+
+		for i := 0; i < 3; i++ {
+			gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(42), gasPrice)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("GetLegacyGas refreshes prices in the background", func(t *testing.T) {
+		var price atomic.Int64
+		price.Store(42)
+		oracleCfg := newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"gasPrice": "%d"}`, price.Load())
+		})
+		fallback := mocks.NewEvmEstimator(t)
+		fallback.On("Start", mock.Anything).Return(nil)
+		fallback.On("Close").Return(nil)
+		oracleCfg.PollPeriodF = tests.TestInterval
+		o := gas.NewRemoteEstimator(logger.Test(t), cfg, oracleCfg, bhCfg, chainID, fallback, nil)
+		servicetest.Run(t, o)
+		requirePricesFetched(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+
+		price.Store(43)
+		require.Eventually(t, func() bool {
+			gasPrice, _, err = o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+			return err == nil && gasPrice.Equal(assets.NewWeiI(43))
+		}, tests.WaitTimeout(t), tests.TestInterval)
+	})
+
+	t.Run("Start does not wait for the first poll", func(t *testing.T) {
+		chRespond := make(chan struct{})
+		o, fallback := newEstimator(t, newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-chRespond:
+			case <-r.Context().Done():
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"gasPrice": "42"}`)
+		}))
+		fallback.On("GetLegacyGas", mock.Anything, calldata, gasLimit, maxGasPrice).Return(assets.NewWeiI(33), gasLimit, nil).Once()
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(33), gasPrice)
+
+		close(chRespond)
+		requirePricesFetched(t, o)
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(42), gasPrice)
+	})
+
+	t.Run("GetLegacyGas clamps the price by PriceMin and PriceMax", func(t *testing.T) {
+		o, _ := newEstimator(t, newRemoteOracle(t, respondWith(`{"gasPrice": "1 wei"}`)))
+		requirePricesFetched(t, o)
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), gasPrice)
+
+		o, _ = newEstimator(t, newRemoteOracle(t, respondWith(`{"gasPrice": "1 gwei"}`)))
+		requirePricesFetched(t, o)
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(80), gasPrice)
+
+		gasPrice, _, err = o.GetLegacyGas(tests.Context(t), calldata, gasLimit, assets.NewWeiI(50))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), gasPrice)
+	})
+
+	t.Run("GetLegacyGas falls back on timeout", func(t *testing.T) {
+		oracleCfg := newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(tests.WaitTimeout(t)):
+			}
+		})
+		o, fallback := newEstimator(t, oracleCfg)
+		fallback.On("GetLegacyGas", mock.Anything, calldata, gasLimit, maxGasPrice).Return(assets.NewWeiI(33), gasLimit, nil).Once()
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(33), gasPrice)
+	})
+
+	t.Run("GetLegacyGas falls back on oversized response", func(t *testing.T) {
+		o, fallback := newEstimator(t, newRemoteOracle(t, respondWith(`{"gasPrice": "42", "padding": "`+strings.Repeat("0", 64*1024)+`"}`)))
+		fallback.On("GetLegacyGas", mock.Anything, calldata, gasLimit, maxGasPrice).Return(assets.NewWeiI(33), gasLimit, nil)
+
+		require.Never(t, o.PricesFetched, 10*tests.TestInterval, tests.TestInterval)
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(33), gasPrice)
+	})
+
+	t.Run("GetLegacyGas falls back on error response", func(t *testing.T) {
+		o, fallback := newEstimator(t, newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		fallback.On("GetLegacyGas", mock.Anything, calldata, gasLimit, maxGasPrice).Return(assets.NewWeiI(33), gasLimit, nil).Once()
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(33), gasPrice)
+	})
+
+	t.Run("BumpLegacyGas uses the oracle price if it's higher than bumped price", func(t *testing.T) {
+		o, _ := newEstimator(t, newRemoteOracle(t, respondWith(`{"gasPrice": "60"}`)))
+		requirePricesFetched(t, o)
+		gasPrice, _, err := o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(20), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(60), gasPrice)
+
+		gasPrice, _, err = o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(70), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(77), gasPrice)
+	})
+
+	t.Run("GetDynamicFee clamps fee cap and tip cap", func(t *testing.T) {
+		o, _ := newEstimator(t, newRemoteOracle(t, respondWith(`{"maxFeePerGas": "200", "maxPriorityFeePerGas": "1"}`)))
+		requirePricesFetched(t, o)
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(80), fee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(5), fee.GasTipCap)
+
+		o, _ = newEstimator(t, newRemoteOracle(t, respondWith(`{"maxFeePerGas": "1", "maxPriorityFeePerGas": "1"}`)))
+		requirePricesFetched(t, o)
+		fee, err = o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), fee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(5), fee.GasTipCap)
+	})
+
+	t.Run("GetDynamicFee falls back if response does not contain dynamic fees", func(t *testing.T) {
+		o, fallback := newEstimator(t, newRemoteOracle(t, respondWith(`{"gasPrice": "42"}`)))
+		requirePricesFetched(t, o)
+		expected := gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(7)}
+		fallback.On("GetDynamicFee", mock.Anything, maxGasPrice).Return(expected, nil).Once()
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, expected, fee)
+	})
+
+	t.Run("BumpDynamicFee falls back if oracle is not available", func(t *testing.T) {
+		o, fallback := newEstimator(t, newRemoteOracle(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		original := gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(7)}
+		expected := gas.DynamicFee{GasFeeCap: assets.NewWeiI(55), GasTipCap: assets.NewWeiI(8)}
+		fallback.On("BumpDynamicFee", mock.Anything, original, maxGasPrice, mock.Anything).Return(expected, nil).Once()
+
+		fee, err := o.BumpDynamicFee(tests.Context(t), original, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, fee)
+	})
+
+	t.Run("BumpDynamicFee bumps tip cap to at least the oracle price", func(t *testing.T) {
+		o, _ := newEstimator(t, newRemoteOracle(t, respondWith(`{"maxFeePerGas": "60", "maxPriorityFeePerGas": "20"}`)))
+		requirePricesFetched(t, o)
+		original := gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(7)}
+
+		fee, err := o.BumpDynamicFee(tests.Context(t), original, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), fee.GasTipCap)
+		assert.Equal(t, assets.NewWeiI(55), fee.GasFeeCap)
+	})
+}
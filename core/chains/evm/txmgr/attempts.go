@@ -8,6 +8,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -44,9 +47,12 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
+// Transactions with blobs are always sent as EIP-4844 blob transactions
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if len(etx.Blobs) > 0 {
+		txType = 0x3
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -56,7 +62,11 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	if txType == 0x3 {
+		fee, feeLimit, err = c.EvmFeeEstimator.GetBlobFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress)
+	} else {
+		fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	}
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
@@ -132,6 +142,19 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return blob fee bump", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		var sidecar *types.BlobTxSidecar
+		sidecar, err = newBlobTxSidecar(etx.Blobs)
+		if err != nil {
+			return attempt, false, pkgerrors.Wrap(err, "failed to build blob sidecar") // not retryable, blobs will not change
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, fee, sidecar, gasLimit)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -197,6 +220,41 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, sidecar *types.BlobTxSidecar, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee.DynamicFee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if fee.BlobFeeCap.ToInt().Cmp(Max256BitUInt) > 0 {
+		return attempt, pkgerrors.New("impossibly large blob fee cap")
+	}
+
+	b, err := newBlobTransaction(
+		uint64(*etx.Sequence),
+		etx.ToAddress,
+		&etx.Value,
+		gasLimit,
+		&c.chainID,
+		fee,
+		etx.EncodedPayload,
+		sidecar,
+	)
+	if err != nil {
+		return attempt, err
+	}
+	tx := types.NewTx(&b)
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+		BlobFeeCap: fee.BlobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -248,6 +306,61 @@ func newDynamicFeeTransaction(nonce uint64, to common.Address, value *big.Int, g
 	}
 }
 
+func newBlobTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID *big.Int, fee gas.EvmFee, data []byte, sidecar *types.BlobTxSidecar) (types.BlobTx, error) {
+	var overflow [5]bool
+	b := types.BlobTx{
+		Nonce:      nonce,
+		Gas:        gasLimit,
+		To:         to,
+		Data:       data,
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}
+	b.ChainID, overflow[0] = uint256.FromBig(chainID)
+	b.GasTipCap, overflow[1] = uint256.FromBig(fee.GasTipCap.ToInt())
+	b.GasFeeCap, overflow[2] = uint256.FromBig(fee.GasFeeCap.ToInt())
+	b.BlobFeeCap, overflow[3] = uint256.FromBig(fee.BlobFeeCap.ToInt())
+	b.Value, overflow[4] = uint256.FromBig(value)
+	for _, o := range overflow {
+		if o {
+			return b, pkgerrors.New("blob transaction field overflows 256 bits")
+		}
+	}
+	return b, nil
+}
+
+// newBlobTxSidecar builds the sidecar with KZG commitments and proofs for the blobs of a transaction.
+// Blobs shorter than the blob size are right padded with zeros. Each 32 bytes field element of a blob must be lower
+// than the BLS modulus, so callers are responsible for encoding the data accordingly.
+func newBlobTxSidecar(blobs [][]byte) (*types.BlobTxSidecar, error) {
+	maxBlobs := int(params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob)
+	if len(blobs) > maxBlobs {
+		return nil, pkgerrors.Errorf("transaction has %d blobs, but at most %d are allowed", len(blobs), maxBlobs)
+	}
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       make([]kzg4844.Blob, len(blobs)),
+		Commitments: make([]kzg4844.Commitment, len(blobs)),
+		Proofs:      make([]kzg4844.Proof, len(blobs)),
+	}
+	for i, blob := range blobs {
+		if len(blob) > len(kzg4844.Blob{}) {
+			return nil, pkgerrors.Errorf("blob %d has %d bytes, but at most %d are allowed", i, len(blob), len(kzg4844.Blob{}))
+		}
+		copy(sidecar.Blobs[i][:], blob)
+		commitment, err := kzg4844.BlobToCommitment(sidecar.Blobs[i])
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute commitment of blob %d", i)
+		}
+		proof, err := kzg4844.ComputeBlobProof(sidecar.Blobs[i], commitment)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute proof of blob %d", i)
+		}
+		sidecar.Commitments[i] = commitment
+		sidecar.Proofs[i] = proof
+	}
+	return sidecar, nil
+}
+
 func (c *evmTxAttemptBuilder) newLegacyAttempt(ctx context.Context, etx Tx, gasPrice *assets.Wei, gasLimit uint64) (attempt TxAttempt, err error) {
 	if err = validateLegacyGas(c.feeConfig, gasPrice, etx); err != nil {
 		return attempt, pkgerrors.Wrap(err, "error validating gas")
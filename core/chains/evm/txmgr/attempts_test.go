@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -191,6 +193,77 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobTx(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		})
+	var n evmtypes.Nonce
+	lggr := logger.Test(t)
+	feeCfg := newFeeConfig()
+	feeCfg.priceMax = assets.GWei(200)
+	blobFee := gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasTipCap: assets.GWei(100), GasFeeCap: assets.GWei(200)},
+		BlobFeeCap: assets.GWei(10),
+	}
+	blobs := [][]byte{{1, 2, 3}, {4, 5, 6}}
+
+	t.Run("creates attempt with fields and sidecar", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: blobs}, blobFee, 100, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, 100, int(a.ChainSpecificFeeLimit))
+		assert.Nil(t, a.TxFee.GasPrice)
+		assert.Equal(t, assets.GWei(100).String(), a.TxFee.GasTipCap.String())
+		assert.Equal(t, assets.GWei(200).String(), a.TxFee.GasFeeCap.String())
+		assert.Equal(t, assets.GWei(10).String(), a.TxFee.BlobFeeCap.String())
+
+		tx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(types.BlobTxType), tx.Type())
+		assert.Equal(t, a.Hash, tx.Hash())
+		assert.Equal(t, assets.GWei(10).ToInt(), tx.BlobGasFeeCap())
+		sidecar := tx.BlobTxSidecar()
+		require.NotNil(t, sidecar)
+		require.Len(t, sidecar.Blobs, len(blobs))
+		assert.Equal(t, sidecar.BlobHashes(), tx.BlobHashes())
+		for i := range blobs {
+			assert.Equal(t, blobs[i], sidecar.Blobs[i][:len(blobs[i])])
+			require.NoError(t, kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]))
+		}
+	})
+
+	t.Run("new attempt uses blob tx type and blob fee if tx has blobs", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetBlobFee", mock.Anything, mock.Anything, uint64(100), feeCfg.priceMax, &addr, mock.Anything).Return(blobFee, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, est)
+		a, fee, _, _, err := cks.NewTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, FeeLimit: 100, Blobs: blobs}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, blobFee, fee)
+	})
+
+	t.Run("fails with non-retryable error for invalid blobs", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+		tooLarge := make([]byte, len(kzg4844.Blob{})+1)
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{tooLarge}}, blobFee, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "failed to build blob sidecar")
+		assert.False(t, retryable)
+
+		// field elements must be lower than the BLS modulus
+		invalid := make([]byte, 32)
+		for i := range invalid {
+			invalid[i] = 0xff
+		}
+		_, retryable, err = cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: [][]byte{invalid}}, blobFee, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "failed to build blob sidecar")
+		assert.False(t, retryable)
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
@@ -324,6 +397,14 @@ func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 		assert.False(t, retryable)
 	})
 
+	t.Run("dynamic fee with blob tx type", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{
+			DynamicFee: dynamicFee,
+		}, 100, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+
 	t.Run("invalid type", func(t *testing.T) {
 		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), txmgr.Tx{}, gas.EvmFee{}, 100, 0xA, lggr)
 		require.Error(t, err)
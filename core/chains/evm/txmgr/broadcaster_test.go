@@ -733,6 +733,49 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Priority(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	newTxRequest := func(payload byte, priority txmgrtypes.TxPriority) txmgr.TxRequest {
+		return txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{payload},
+			FeeLimit:       21000,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Priority:       priority,
+		}
+	}
+	bulk := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(1, txmgrtypes.TxPriorityBulk), testutils.FixtureChainID)
+	normal := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(2, txmgrtypes.TxPriorityNormal), testutils.FixtureChainID)
+	critical := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(3, txmgrtypes.TxPriorityCritical), testutils.FixtureChainID)
+
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Times(3)
+
+	retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	for expectedNonce, etx := range []txmgr.Tx{critical, normal, bulk} {
+		etx, err = txStore.FindTxWithAttempts(tests.Context(t), etx.ID)
+		require.NoError(t, err)
+		require.NotNil(t, etx.Sequence)
+		assert.Equal(t, evmtypes.Nonce(expectedNonce), *etx.Sequence, "tx with priority %s", etx.Priority)
+	}
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
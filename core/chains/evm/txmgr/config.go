@@ -26,6 +26,7 @@ type FeeConfig interface {
 	BumpThreshold() uint64
 	BumpTxDepth() uint32
 	LimitDefault() uint64
+	LimitTransfer() uint64
 	PriceDefault() *assets.Wei
 	TipCapMin() *assets.Wei
 	PriceMax() *assets.Wei
@@ -48,6 +48,10 @@ type EvmTxStore interface {
 	// methods used solely in EVM components
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []Receipt, err error)
 	UpdateTxStatesToFinalizedUsingReceiptIds(ctx context.Context, etxIDs []int64, chainId *big.Int) error
+	FindNoncesInUseFromAddress(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) ([]evmtypes.Nonce, error)
+	FindTxsToResequence(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) (etxIDs []int64, minedIDs []int64, err error)
+	ResequenceTxs(ctx context.Context, etxIDs []int64) (requeuedIDs []int64, err error)
+	CreateTxInProgress(ctx context.Context, etx *Tx, attempt *TxAttempt) error
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -199,6 +203,10 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// Blobs of EIP-4844 blob transaction
+	Blobs pq.ByteaArray
+	// Priority of the tx, higher priority txs are broadcast first
+	Priority txmgrtypes.TxPriority
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +229,8 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Blobs = tx.Blobs
+	db.Priority = tx.Priority
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +266,8 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Blobs = db.Blobs
+	tx.Priority = db.Priority
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -289,6 +301,7 @@ type DbEthTxAttempt struct {
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
 	IsPurgeAttempt          bool
+	BlobFeeCap              *assets.Wei
 }
 
 func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
@@ -303,6 +316,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -333,6 +347,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 	attempt.TxFee = gas.EvmFee{
 		GasPrice:   db.GasPrice,
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		BlobFeeCap: db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -358,8 +373,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt, blob_fee_cap)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt, :blob_fee_cap)
 RETURNING *;
 `
 
@@ -530,8 +545,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, blobs, priority) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :blobs, :priority
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -1594,19 +1609,28 @@ func (o *evmTxStore) SaveReplacementInProgressAttempt(ctx context.Context, oldAt
 }
 
 // Finds earliest saved transaction that has yet to be broadcast from the given address
-func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*Tx, error) {
+func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration) (*Tx, bool, error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	var dbEtx struct {
+		DbEthTx
+		Promoted bool
+	}
+	// Txs that have been waiting for longer than priorityMaxWait are promoted to critical, zero disables the promotion.
+	// A tx was promoted if an unstarted tx of a higher priority had to wait for it.
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT *, priority < (
+	SELECT MAX(priority) FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+) AS promoted FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2
+ORDER BY CASE WHEN $3::float8 > 0 AND created_at < NOW() - make_interval(secs => $3::float8) THEN $4::smallint ELSE priority END DESC, value ASC, created_at ASC, id ASC`,
+		fromAddress, chainID.String(), priorityMaxWait.Seconds(), txmgrtypes.TxPriorityCritical)
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
-		return nil, pkgerrors.Wrap(err, "failed to FindNextUnstartedTransactionFromAddress")
+		return nil, false, pkgerrors.Wrap(err, "failed to FindNextUnstartedTransactionFromAddress")
 	}
 
-	return etx, nil
+	return etx, dbEtx.Promoted, nil
 }
 
 func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etx *Tx) error {
@@ -1797,6 +1821,32 @@ func (o *evmTxStore) CountUnconfirmedTransactions(ctx context.Context, fromAddre
 	return o.countTransactionsWithState(ctx, fromAddress, txmgr.TxUnconfirmed, chainID)
 }
 
+// CountInFlightTransactions returns the number of unstarted and unconfirmed transactions of each of the given addresses
+func (o *evmTxStore) CountInFlightTransactions(ctx context.Context, fromAddresses []common.Address, chainID *big.Int) (counts map[common.Address]uint32, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	addrsBytea := make([][]byte, len(fromAddresses))
+	for i, addr := range fromAddresses {
+		addrsBytea[i] = addr.Bytes()
+	}
+	var rows []struct {
+		FromAddress common.Address `db:"from_address"`
+		Count       uint32
+	}
+	err = o.q.SelectContext(ctx, &rows, `SELECT from_address, count(*) FROM evm.txes
+WHERE from_address = ANY($1) AND state IN ('unstarted', 'unconfirmed') AND evm_chain_id = $2 GROUP BY from_address`,
+		addrsBytea, chainID.String())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to CountInFlightTransactions")
+	}
+	counts = make(map[common.Address]uint32, len(rows))
+	for _, row := range rows {
+		counts[row.FromAddress] = row.Count
+	}
+	return counts, nil
+}
+
 // CountTransactionsByState returns the number of transactions with any fromAddress in the given state
 func (o *evmTxStore) CountTransactionsByState(ctx context.Context, state txmgrtypes.TxState, chainID *big.Int) (count uint32, err error) {
 	var cancel context.CancelFunc
@@ -1853,12 +1903,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, blobs, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, pq.ByteaArray(txRequest.Blobs), txRequest.Priority)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1995,6 +2045,102 @@ func (o *evmTxStore) Abandon(ctx context.Context, chainID *big.Int, addr common.
 	return err
 }
 
+// FindNoncesInUseFromAddress returns the nonces greater than or equal to minNonce that are assigned to a transaction, in ascending order
+func (o *evmTxStore) FindNoncesInUseFromAddress(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) (nonces []evmtypes.Nonce, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &nonces, `SELECT DISTINCT nonce FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce IS NOT NULL AND nonce >= $3 ORDER BY nonce ASC`, fromAddress, chainID.String(), minNonce.Int64())
+	return nonces, pkgerrors.Wrap(err, "FindNoncesInUseFromAddress failed")
+}
+
+// FindTxsToResequence returns the IDs of the unconfirmed transactions with a nonce greater than minNonce, in ascending
+// nonce order. Transactions with a receipt for any of their attempts are returned in minedIDs instead, since they may
+// already be included on-chain.
+func (o *evmTxStore) FindTxsToResequence(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) (etxIDs []int64, minedIDs []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var rows []struct {
+		ID    int64
+		Mined bool
+	}
+	err = o.q.SelectContext(ctx, &rows, `SELECT evm.txes.id, EXISTS (
+	SELECT 1 FROM evm.tx_attempts JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash WHERE evm.tx_attempts.eth_tx_id = evm.txes.id
+) AS mined
+FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND state = 'unconfirmed' AND nonce > $3 ORDER BY nonce ASC`, fromAddress, chainID.String(), minNonce.Int64())
+	if err != nil {
+		return nil, nil, pkgerrors.Wrap(err, "FindTxsToResequence failed")
+	}
+	for _, row := range rows {
+		if row.Mined {
+			minedIDs = append(minedIDs, row.ID)
+		} else {
+			etxIDs = append(etxIDs, row.ID)
+		}
+	}
+	return
+}
+
+// ResequenceTxs abandons the given unconfirmed transactions and queues an unstarted copy of each of them, in the given
+// order, so that the Broadcaster assigns them new nonces. The copies take over the pipeline task run and idempotency key
+// of the abandoned transactions. Returns the IDs of the copies.
+func (o *evmTxStore) ResequenceTxs(ctx context.Context, etxIDs []int64) (requeuedIDs []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		requeuedIDs = make([]int64, 0, len(etxIDs))
+		for _, id := range etxIDs {
+			var dbEtx DbEthTx
+			if err = orm.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE id = $1 AND state = 'unconfirmed' FOR UPDATE`, id); err != nil {
+				return pkgerrors.Wrapf(err, "failed to load unconfirmed transaction %d", id)
+			}
+			// the unique pipeline task run and idempotency key are moved to the copy
+			if _, err = orm.q.ExecContext(ctx, `UPDATE evm.txes SET state = 'fatal_error', nonce = NULL, error = 'abandoned: resequenced to repair nonce gap', pipeline_task_run_id = NULL, idempotency_key = NULL WHERE id = $1`, id); err != nil {
+				return pkgerrors.Wrapf(err, "failed to abandon transaction %d", id)
+			}
+			dbEtx.Nonce = nil
+			dbEtx.Error = nullv4.String{}
+			dbEtx.BroadcastAt = nil
+			dbEtx.InitialBroadcastAt = nil
+			dbEtx.State = txmgr.TxUnstarted
+			dbEtx.CallbackCompleted = false
+			var etx Tx
+			dbEtx.ToTx(&etx)
+			if err = orm.InsertTx(ctx, &etx); err != nil {
+				return pkgerrors.Wrapf(err, "failed to queue copy of transaction %d", id)
+			}
+			requeuedIDs = append(requeuedIDs, etx.ID)
+		}
+		return nil
+	})
+	return
+}
+
+// CreateTxInProgress stores a new transaction with an assigned nonce as in_progress, along with its in_progress attempt,
+// so that the Broadcaster never sees it as unstarted. Either both are stored, or neither.
+func (o *evmTxStore) CreateTxInProgress(ctx context.Context, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if etx.Sequence == nil {
+		return errors.New("in_progress transaction must have nonce")
+	}
+	if attempt.State != txmgrtypes.TxAttemptInProgress {
+		return errors.New("attempt state must be in_progress")
+	}
+	etx.State = txmgr.TxInProgress
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		if err := orm.InsertTx(ctx, etx); err != nil {
+			return pkgerrors.Wrap(err, "CreateTxInProgress failed to insert evm tx")
+		}
+		attempt.TxID = etx.ID
+		attempt.Tx = *etx
+		return pkgerrors.Wrap(orm.InsertTxAttempt(ctx, attempt), "CreateTxInProgress failed to insert evm tx attempt")
+	})
+}
+
 // Find transactions by a field in the TxMeta blob and transaction states
 func (o *evmTxStore) FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) ([]*Tx, error) {
 	var cancel context.CancelFunc
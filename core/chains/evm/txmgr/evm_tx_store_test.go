@@ -1258,16 +1258,71 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	t.Run("cannot find unstarted tx", func(t *testing.T) {
 		mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
 
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, _, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 0)
 		assert.ErrorIs(t, err, sql.ErrNoRows)
 		assert.Nil(t, resultEtx)
 	})
 
 	t.Run("finds unstarted tx", func(t *testing.T) {
 		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		resultEtx, promoted, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 0)
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
+		assert.False(t, promoted)
+	})
+
+	t.Run("finds unstarted tx of the highest priority", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(tx *txmgr.TxRequest) {
+			tx.Priority = txmgrtypes.TxPriorityBulk
+		})
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		critical := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(tx *txmgr.TxRequest) {
+			tx.Priority = txmgrtypes.TxPriorityCritical
+		})
+
+		resultEtx, promoted, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, critical.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityCritical, resultEtx.Priority)
+		assert.False(t, promoted)
+	})
+
+	t.Run("promotes lower priority tx that waited for longer than priorityMaxWait", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		bulk := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(tx *txmgr.TxRequest) {
+			tx.Priority = txmgrtypes.TxPriorityBulk
+		})
+		critical := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(tx *txmgr.TxRequest) {
+			tx.Priority = txmgrtypes.TxPriorityCritical
+		})
+		_, err := db.Exec(`UPDATE evm.txes SET created_at = NOW() - interval '1 hour' WHERE id = $1`, bulk.ID)
+		require.NoError(t, err)
+
+		resultEtx, promoted, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, bulk.ID, resultEtx.ID)
+		assert.True(t, promoted)
+
+		// zero disables the promotion
+		resultEtx, promoted, err = txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), 0)
+		require.NoError(t, err)
+		assert.Equal(t, critical.ID, resultEtx.ID)
+		assert.False(t, promoted)
+	})
+
+	t.Run("does not count lower priority tx as promoted if no higher priority tx is waiting", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		bulk := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(tx *txmgr.TxRequest) {
+			tx.Priority = txmgrtypes.TxPriorityBulk
+		})
+		_, err := db.Exec(`UPDATE evm.txes SET created_at = NOW() - interval '1 hour' WHERE id = $1`, bulk.ID)
+		require.NoError(t, err)
+
+		resultEtx, promoted, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID(), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, bulk.ID, resultEtx.ID)
+		assert.False(t, promoted)
 	})
 }
 
@@ -1383,7 +1438,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
 		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
-			nil, txStore, nil, nil, nil, nil, nil)
+			nil, txStore, nil, nil, nil, nil, nil, nil)
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
 
@@ -1562,6 +1617,125 @@ func TestORM_HasInProgressTransaction(t *testing.T) {
 	})
 }
 
+func TestORM_FindNoncesInUseFromAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+	mustInsertInProgressEthTxWithAttempt(t, txStore, 5, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, otherAddress)
+
+	nonces, err := txStore.FindNoncesInUseFromAddress(tests.Context(t), fromAddress, 1, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, []evmtypes.Nonce{1, 3, 5}, nonces)
+}
+
+func TestORM_ResequenceTxs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+	etx4 := mustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 4, fromAddress)
+	etx5 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 5, fromAddress)
+	mustInsertEthReceipt(t, txStore, 42, utils.NewHash(), etx5.TxAttempts[0].Hash)
+	_, err := db.ExecContext(ctx, `UPDATE evm.txes SET idempotency_key = 'resequenced' WHERE id = $1`, etx3.ID)
+	require.NoError(t, err)
+
+	t.Run("finds unconfirmed transactions above the nonce", func(t *testing.T) {
+		ids, minedIDs, err := txStore.FindTxsToResequence(ctx, fromAddress, 2, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{etx3.ID, etx4.ID}, ids)
+		assert.Equal(t, []int64{etx5.ID}, minedIDs)
+	})
+
+	t.Run("abandons transactions and queues copies", func(t *testing.T) {
+		requeuedIDs, err := txStore.ResequenceTxs(ctx, []int64{etx3.ID, etx4.ID})
+		require.NoError(t, err)
+		require.Len(t, requeuedIDs, 2)
+
+		for i, original := range []txmgr.Tx{etx3, etx4} {
+			abandoned, err := txStore.FindTxWithAttempts(ctx, original.ID)
+			require.NoError(t, err)
+			assert.Equal(t, txmgrcommon.TxFatalError, abandoned.State)
+			assert.Nil(t, abandoned.Sequence)
+			assert.Nil(t, abandoned.IdempotencyKey)
+			assert.Contains(t, abandoned.Error.String, "abandoned")
+
+			copied, err := txStore.FindTxWithAttempts(ctx, requeuedIDs[i])
+			require.NoError(t, err)
+			assert.Equal(t, txmgrcommon.TxUnstarted, copied.State)
+			assert.Nil(t, copied.Sequence)
+			assert.Nil(t, copied.BroadcastAt)
+			assert.Empty(t, copied.TxAttempts)
+			assert.Equal(t, original.EncodedPayload, copied.EncodedPayload)
+			assert.Equal(t, original.ToAddress, copied.ToAddress)
+			assert.Equal(t, original.FeeLimit, copied.FeeLimit)
+		}
+		copied, err := txStore.FindTxWithAttempts(ctx, requeuedIDs[0])
+		require.NoError(t, err)
+		require.NotNil(t, copied.IdempotencyKey)
+		assert.Equal(t, "resequenced", *copied.IdempotencyKey)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	})
+
+	t.Run("fails if a transaction is no longer unconfirmed", func(t *testing.T) {
+		_, err := txStore.ResequenceTxs(ctx, []int64{etx3.ID})
+		require.ErrorContains(t, err, fmt.Sprintf("failed to load unconfirmed transaction %d", etx3.ID))
+	})
+}
+
+func TestORM_CreateTxInProgress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	nonce := evmtypes.Nonce(3)
+	newTx := func(addr common.Address) txmgr.Tx {
+		return txmgr.Tx{Sequence: &nonce, FromAddress: addr, ToAddress: addr, EncodedPayload: []byte{}, FeeLimit: 21_000, ChainID: testutils.FixtureChainID}
+	}
+
+	etx := newTx(fromAddress)
+	attempt := cltest.NewLegacyEthTxAttempt(t, 0)
+	attempt.State = txmgrtypes.TxAttemptInProgress
+	require.NoError(t, txStore.CreateTxInProgress(ctx, &etx, &attempt))
+	stored, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxInProgress, stored.State)
+	assert.Equal(t, nonce, *stored.Sequence)
+	require.Len(t, stored.TxAttempts, 1)
+	assert.Equal(t, attempt.Hash, stored.TxAttempts[0].Hash)
+
+	// the transaction is not stored if its attempt cannot be, here because of a duplicate hash
+	etx = newTx(otherAddress)
+	require.Error(t, txStore.CreateTxInProgress(ctx, &etx, &attempt))
+	count, err := txStore.CountTransactionsByState(ctx, txmgrcommon.TxInProgress, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), count)
+}
+
 func TestORM_CountUnconfirmedTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -1582,6 +1756,29 @@ func TestORM_CountUnconfirmedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 3)
 }
 
+func TestORM_CountInFlightTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, idleAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, ignoredAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, otherAddress)
+	mustCreateUnstartedGeneratedTx(t, txStore, ignoredAddress, testutils.FixtureChainID)
+
+	counts, err := txStore.CountInFlightTransactions(tests.Context(t), []common.Address{fromAddress, otherAddress, idleAddress}, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, map[common.Address]uint32{fromAddress: 2, otherAddress: 1}, counts)
+}
+
 func TestORM_CountTransactionsByState(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,118 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+type keySelectorKeyStore interface {
+	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (common.Address, error)
+}
+
+type keySelectorTxManager interface {
+	GetFromAddressLoads(ctx context.Context, addresses []common.Address) ([]FromAddressLoad, error)
+}
+
+type keySelectorBalanceMonitor interface {
+	GetEthBalance(common.Address) *assets.Eth
+}
+
+// KeySelector picks the from address for the next transaction out of a pool of allowed addresses.
+// Addresses are ranked by live signal, so that one backed-up key does not stall every transaction of a job:
+//  1. addresses without a terminally stuck transaction, as reported by the StuckTxDetector
+//  2. addresses with the fewest in-flight (unstarted and unconfirmed) transactions
+//  3. addresses with the highest balance, as reported by the BalanceMonitor
+//
+// Ties are broken by the keystore's round-robin, which also takes care of rejecting disabled keys.
+type KeySelector struct {
+	lggr     logger.SugaredLogger
+	chainID  *big.Int
+	keyStore keySelectorKeyStore
+	txm      keySelectorTxManager
+	balances keySelectorBalanceMonitor
+}
+
+// NewKeySelector returns a KeySelector for the chain. balances may be nil if the BalanceMonitor is disabled, in which
+// case balances are ignored.
+func NewKeySelector(lggr logger.Logger, chainID *big.Int, keyStore keySelectorKeyStore, txm keySelectorTxManager, balances keySelectorBalanceMonitor) *KeySelector {
+	return &KeySelector{
+		lggr:     logger.Sugared(logger.Named(lggr, "KeySelector")),
+		chainID:  chainID,
+		keyStore: keyStore,
+		txm:      txm,
+		balances: balances,
+	}
+}
+
+// SelectFromAddress returns the best address out of addresses to send the next transaction from.
+// If no addresses are given, any enabled key for the chain is picked by round-robin.
+func (s *KeySelector) SelectFromAddress(ctx context.Context, addresses ...common.Address) (common.Address, error) {
+	if len(addresses) < 2 {
+		return s.keyStore.GetRoundRobinAddress(ctx, s.chainID, addresses...)
+	}
+
+	loads, err := s.txm.GetFromAddressLoads(ctx, addresses)
+	if err != nil {
+		s.lggr.Warnw("Failed to get from address loads, falling back to round-robin", "err", err)
+		return s.keyStore.GetRoundRobinAddress(ctx, s.chainID, addresses...)
+	}
+	if len(loads) == 0 {
+		// None of the addresses are enabled, let the keystore return a meaningful error
+		return s.keyStore.GetRoundRobinAddress(ctx, s.chainID, addresses...)
+	}
+
+	best := s.bestAddresses(loads)
+	s.lggr.Debugw("Selecting from address", "candidates", best, "loads", loads)
+	return s.keyStore.GetRoundRobinAddress(ctx, s.chainID, best...)
+}
+
+// bestAddresses returns the addresses which rank equally best
+func (s *KeySelector) bestAddresses(loads []FromAddressLoad) []common.Address {
+	balances := make(map[common.Address]*assets.Eth, len(loads))
+	for _, load := range loads {
+		balances[load.Address] = s.balance(load.Address)
+	}
+	compare := func(a, b FromAddressLoad) int {
+		if a.Stuck != b.Stuck {
+			if a.Stuck {
+				return 1
+			}
+			return -1
+		}
+		if a.InFlight != b.InFlight {
+			if a.InFlight > b.InFlight {
+				return 1
+			}
+			return -1
+		}
+		// Higher balance ranks first
+		return balances[b.Address].Cmp(balances[a.Address])
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		return compare(loads[i], loads[j]) < 0
+	})
+	best := []common.Address{loads[0].Address}
+	for _, load := range loads[1:] {
+		if compare(loads[0], load) != 0 {
+			break
+		}
+		best = append(best, load.Address)
+	}
+	return best
+}
+
+func (s *KeySelector) balance(address common.Address) *assets.Eth {
+	if s.balances != nil {
+		if balance := s.balances.GetEthBalance(address); balance != nil {
+			return balance
+		}
+	}
+	return assets.NewEth(0)
+}
@@ -0,0 +1,131 @@
+package txmgr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+)
+
+type testBalanceMonitor map[common.Address]*assets.Eth
+
+func (b testBalanceMonitor) GetEthBalance(address common.Address) *assets.Eth {
+	return b[address]
+}
+
+func TestKeySelector_SelectFromAddress(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	addr1 := testutils.NewAddress()
+	addr2 := testutils.NewAddress()
+	addr3 := testutils.NewAddress()
+	addresses := []common.Address{addr1, addr2, addr3}
+
+	t.Run("single address is passed to the keystore", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr1).Return(addr1, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, nil).SelectFromAddress(ctx, addr1)
+		require.NoError(t, err)
+		assert.Equal(t, addr1, selected)
+	})
+
+	t.Run("prefers addresses without stuck transactions", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return([]txmgr.FromAddressLoad{
+			{Address: addr1, InFlight: 0, Stuck: true},
+			{Address: addr2, InFlight: 3},
+			{Address: addr3, InFlight: 5},
+		}, nil).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr2).Return(addr2, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, nil).SelectFromAddress(ctx, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, addr2, selected)
+	})
+
+	t.Run("prefers fewest in-flight transactions over balance", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		balances := testBalanceMonitor{addr1: assets.NewEth(100), addr2: assets.NewEth(1), addr3: assets.NewEth(50)}
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return([]txmgr.FromAddressLoad{
+			{Address: addr1, InFlight: 2},
+			{Address: addr2, InFlight: 1},
+			{Address: addr3, InFlight: 2},
+		}, nil).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr2).Return(addr2, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, balances).SelectFromAddress(ctx, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, addr2, selected)
+	})
+
+	t.Run("prefers highest balance if in-flight transactions are equal", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		balances := testBalanceMonitor{addr1: assets.NewEth(1), addr2: assets.NewEth(100)}
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return([]txmgr.FromAddressLoad{
+			{Address: addr1, InFlight: 1},
+			{Address: addr2, InFlight: 1},
+			{Address: addr3, InFlight: 1},
+		}, nil).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr2).Return(addr2, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, balances).SelectFromAddress(ctx, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, addr2, selected)
+	})
+
+	t.Run("round-robins between equally ranked addresses", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return([]txmgr.FromAddressLoad{
+			{Address: addr1, InFlight: 1},
+			{Address: addr2, InFlight: 4},
+			{Address: addr3, InFlight: 1},
+		}, nil).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr1, addr3).Return(addr3, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, nil).SelectFromAddress(ctx, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, addr3, selected)
+	})
+
+	t.Run("falls back to round-robin if loads are not available", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return(nil, errors.New("no db")).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr1, addr2, addr3).Return(addr1, nil).Once()
+
+		selected, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, nil).SelectFromAddress(ctx, addresses...)
+		require.NoError(t, err)
+		assert.Equal(t, addr1, selected)
+	})
+
+	t.Run("returns keystore error if none of the addresses are enabled", func(t *testing.T) {
+		ks := ksmocks.NewEth(t)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		txm.On("GetFromAddressLoads", mock.Anything, addresses).Return(nil, nil).Once()
+		ks.On("GetRoundRobinAddress", mock.Anything, chainID, addr1, addr2, addr3).Return(common.Address{}, errors.New("no sending keys available")).Once()
+
+		_, err := txmgr.NewKeySelector(lggr, chainID, ks, txm, nil).SelectFromAddress(ctx, addresses...)
+		require.ErrorContains(t, err, "no sending keys available")
+	})
+}
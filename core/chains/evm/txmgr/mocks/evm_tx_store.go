@@ -167,6 +167,66 @@ func (_c *EvmTxStore_Close_Call) RunAndReturn(run func()) *EvmTxStore_Close_Call
 	return _c
 }
 
+// CountInFlightTransactions provides a mock function with given fields: ctx, fromAddresses, chainID
+func (_m *EvmTxStore) CountInFlightTransactions(ctx context.Context, fromAddresses []common.Address, chainID *big.Int) (map[common.Address]uint32, error) {
+	ret := _m.Called(ctx, fromAddresses, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountInFlightTransactions")
+	}
+
+	var r0 map[common.Address]uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) (map[common.Address]uint32, error)); ok {
+		return rf(ctx, fromAddresses, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, *big.Int) map[common.Address]uint32); ok {
+		r0 = rf(ctx, fromAddresses, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Address]uint32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Address, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddresses, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_CountInFlightTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountInFlightTransactions'
+type EvmTxStore_CountInFlightTransactions_Call struct {
+	*mock.Call
+}
+
+// CountInFlightTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddresses []common.Address
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) CountInFlightTransactions(ctx interface{}, fromAddresses interface{}, chainID interface{}) *EvmTxStore_CountInFlightTransactions_Call {
+	return &EvmTxStore_CountInFlightTransactions_Call{Call: _e.mock.On("CountInFlightTransactions", ctx, fromAddresses, chainID)}
+}
+
+func (_c *EvmTxStore_CountInFlightTransactions_Call) Run(run func(ctx context.Context, fromAddresses []common.Address, chainID *big.Int)) *EvmTxStore_CountInFlightTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]common.Address), args[2].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CountInFlightTransactions_Call) Return(counts map[common.Address]uint32, err error) *EvmTxStore_CountInFlightTransactions_Call {
+	_c.Call.Return(counts, err)
+	return _c
+}
+
+func (_c *EvmTxStore_CountInFlightTransactions_Call) RunAndReturn(run func(context.Context, []common.Address, *big.Int) (map[common.Address]uint32, error)) *EvmTxStore_CountInFlightTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CountTransactionsByState provides a mock function with given fields: ctx, state, chainID
 func (_m *EvmTxStore) CountTransactionsByState(ctx context.Context, state types.TxState, chainID *big.Int) (uint32, error) {
 	ret := _m.Called(ctx, state, chainID)
@@ -399,6 +459,54 @@ func (_c *EvmTxStore_CreateTransaction_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// CreateTxInProgress provides a mock function with given fields: ctx, etx, attempt
+func (_m *EvmTxStore) CreateTxInProgress(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTxInProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_CreateTxInProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTxInProgress'
+type EvmTxStore_CreateTxInProgress_Call struct {
+	*mock.Call
+}
+
+// CreateTxInProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - attempt *types.TxAttempt[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) CreateTxInProgress(ctx interface{}, etx interface{}, attempt interface{}) *EvmTxStore_CreateTxInProgress_Call {
+	return &EvmTxStore_CreateTxInProgress_Call{Call: _e.mock.On("CreateTxInProgress", ctx, etx, attempt)}
+}
+
+func (_c *EvmTxStore_CreateTxInProgress_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_CreateTxInProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[2].(*types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_CreateTxInProgress_Call) Return(_a0 error) *EvmTxStore_CreateTxInProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_CreateTxInProgress_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_CreateTxInProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteInProgressAttempt provides a mock function with given fields: ctx, attempt
 func (_m *EvmTxStore) DeleteInProgressAttempt(ctx context.Context, attempt types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, attempt)
@@ -678,34 +786,41 @@ func (_c *EvmTxStore_FindLatestSequence_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID
-func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
-	ret := _m.Called(ctx, fromAddress, chainID)
+// FindNextUnstartedTransactionFromAddress provides a mock function with given fields: ctx, fromAddress, chainID, priorityMaxWait
+func (_m *EvmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], bool, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, priorityMaxWait)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindNextUnstartedTransactionFromAddress")
 	}
 
 	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
-		return rf(ctx, fromAddress, chainID)
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], bool, error)); ok {
+		return rf(ctx, fromAddress, chainID, priorityMaxWait)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
-		r0 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, time.Duration) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, priorityMaxWait)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int) error); ok {
-		r1 = rf(ctx, fromAddress, chainID)
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, time.Duration) bool); ok {
+		r1 = rf(ctx, fromAddress, chainID, priorityMaxWait)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, common.Address, *big.Int, time.Duration) error); ok {
+		r2 = rf(ctx, fromAddress, chainID, priorityMaxWait)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // EvmTxStore_FindNextUnstartedTransactionFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNextUnstartedTransactionFromAddress'
@@ -717,23 +832,85 @@ type EvmTxStore_FindNextUnstartedTransactionFromAddress_Call struct {
 //   - ctx context.Context
 //   - fromAddress common.Address
 //   - chainID *big.Int
-func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
-	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID)}
+//   - priorityMaxWait time.Duration
+func (_e *EvmTxStore_Expecter) FindNextUnstartedTransactionFromAddress(ctx interface{}, fromAddress interface{}, chainID interface{}, priorityMaxWait interface{}) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+	return &EvmTxStore_FindNextUnstartedTransactionFromAddress_Call{Call: _e.mock.On("FindNextUnstartedTransactionFromAddress", ctx, fromAddress, chainID, priorityMaxWait)}
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, priorityMaxWait time.Duration)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int))
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(time.Duration))
 	})
 	return _c
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Return(_a0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 error) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) Return(_a0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], _a1 bool, _a2 error) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, time.Duration) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], bool, error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindNoncesInUseFromAddress provides a mock function with given fields: ctx, fromAddress, minNonce, chainID
+func (_m *EvmTxStore) FindNoncesInUseFromAddress(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) ([]evmtypes.Nonce, error) {
+	ret := _m.Called(ctx, fromAddress, minNonce, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNoncesInUseFromAddress")
+	}
+
+	var r0 []evmtypes.Nonce
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) ([]evmtypes.Nonce, error)); ok {
+		return rf(ctx, fromAddress, minNonce, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) []evmtypes.Nonce); ok {
+		r0 = rf(ctx, fromAddress, minNonce, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]evmtypes.Nonce)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, minNonce, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindNoncesInUseFromAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNoncesInUseFromAddress'
+type EvmTxStore_FindNoncesInUseFromAddress_Call struct {
+	*mock.Call
+}
+
+// FindNoncesInUseFromAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - minNonce evmtypes.Nonce
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindNoncesInUseFromAddress(ctx interface{}, fromAddress interface{}, minNonce interface{}, chainID interface{}) *EvmTxStore_FindNoncesInUseFromAddress_Call {
+	return &EvmTxStore_FindNoncesInUseFromAddress_Call{Call: _e.mock.On("FindNoncesInUseFromAddress", ctx, fromAddress, minNonce, chainID)}
+}
+
+func (_c *EvmTxStore_FindNoncesInUseFromAddress_Call) Run(run func(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int)) *EvmTxStore_FindNoncesInUseFromAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(evmtypes.Nonce), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindNoncesInUseFromAddress_Call) Return(_a0 []evmtypes.Nonce, _a1 error) *EvmTxStore_FindNoncesInUseFromAddress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call {
+func (_c *EvmTxStore_FindNoncesInUseFromAddress_Call) RunAndReturn(run func(context.Context, common.Address, evmtypes.Nonce, *big.Int) ([]evmtypes.Nonce, error)) *EvmTxStore_FindNoncesInUseFromAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1823,6 +2000,76 @@ func (_c *EvmTxStore_FindTxsRequiringResubmissionDueToInsufficientFunds_Call) Ru
 	return _c
 }
 
+// FindTxsToResequence provides a mock function with given fields: ctx, fromAddress, minNonce, chainID
+func (_m *EvmTxStore) FindTxsToResequence(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) ([]int64, []int64, error) {
+	ret := _m.Called(ctx, fromAddress, minNonce, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxsToResequence")
+	}
+
+	var r0 []int64
+	var r1 []int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) ([]int64, []int64, error)); ok {
+		return rf(ctx, fromAddress, minNonce, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) []int64); ok {
+		r0 = rf(ctx, fromAddress, minNonce, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) []int64); ok {
+		r1 = rf(ctx, fromAddress, minNonce, chainID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, common.Address, evmtypes.Nonce, *big.Int) error); ok {
+		r2 = rf(ctx, fromAddress, minNonce, chainID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmTxStore_FindTxsToResequence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxsToResequence'
+type EvmTxStore_FindTxsToResequence_Call struct {
+	*mock.Call
+}
+
+// FindTxsToResequence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - minNonce evmtypes.Nonce
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindTxsToResequence(ctx interface{}, fromAddress interface{}, minNonce interface{}, chainID interface{}) *EvmTxStore_FindTxsToResequence_Call {
+	return &EvmTxStore_FindTxsToResequence_Call{Call: _e.mock.On("FindTxsToResequence", ctx, fromAddress, minNonce, chainID)}
+}
+
+func (_c *EvmTxStore_FindTxsToResequence_Call) Run(run func(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int)) *EvmTxStore_FindTxsToResequence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(evmtypes.Nonce), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxsToResequence_Call) Return(etxIDs []int64, minedIDs []int64, err error) *EvmTxStore_FindTxsToResequence_Call {
+	_c.Call.Return(etxIDs, minedIDs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxsToResequence_Call) RunAndReturn(run func(context.Context, common.Address, evmtypes.Nonce, *big.Int) ([]int64, []int64, error)) *EvmTxStore_FindTxsToResequence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbandonedTransactionsByBatch provides a mock function with given fields: ctx, chainID, enabledAddrs, offset, limit
 func (_m *EvmTxStore) GetAbandonedTransactionsByBatch(ctx context.Context, chainID *big.Int, enabledAddrs []common.Address, offset uint, limit uint) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, chainID, enabledAddrs, offset, limit)
@@ -2419,6 +2666,65 @@ func (_c *EvmTxStore_ReapTxHistory_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ResequenceTxs provides a mock function with given fields: ctx, etxIDs
+func (_m *EvmTxStore) ResequenceTxs(ctx context.Context, etxIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, etxIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResequenceTxs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]int64, error)); ok {
+		return rf(ctx, etxIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []int64); ok {
+		r0 = rf(ctx, etxIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, etxIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_ResequenceTxs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResequenceTxs'
+type EvmTxStore_ResequenceTxs_Call struct {
+	*mock.Call
+}
+
+// ResequenceTxs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxIDs []int64
+func (_e *EvmTxStore_Expecter) ResequenceTxs(ctx interface{}, etxIDs interface{}) *EvmTxStore_ResequenceTxs_Call {
+	return &EvmTxStore_ResequenceTxs_Call{Call: _e.mock.On("ResequenceTxs", ctx, etxIDs)}
+}
+
+func (_c *EvmTxStore_ResequenceTxs_Call) Run(run func(ctx context.Context, etxIDs []int64)) *EvmTxStore_ResequenceTxs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_ResequenceTxs_Call) Return(requeuedIDs []int64, err error) *EvmTxStore_ResequenceTxs_Call {
+	_c.Call.Return(requeuedIDs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_ResequenceTxs_Call) RunAndReturn(run func(context.Context, []int64) ([]int64, error)) *EvmTxStore_ResequenceTxs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -43,6 +43,9 @@ type (
 	TransactionClient      = txmgrtypes.TransactionClient[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
 	ChainReceipt           = txmgrtypes.ChainReceipt[common.Hash, common.Hash]
 	Finalizer              = txmgrtypes.Finalizer[common.Hash, *evmtypes.Head]
+	SequenceGapRepairer    = txmgrtypes.SequenceGapRepairer[common.Address, evmtypes.Nonce]
+	SequenceGapReport      = txmgrtypes.SequenceGapReport[common.Address, evmtypes.Nonce]
+	FromAddressLoad        = txmgrtypes.FromAddressLoad[common.Address]
 )
 
 var _ KeyStore = (keystore.Eth)(nil) // check interface in txmgr to avoid circular import
@@ -0,0 +1,202 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var _ SequenceGapRepairer = (*nonceGapRepairer)(nil)
+
+type nonceGapRepairerTxStore interface {
+	CreateTxInProgress(ctx context.Context, etx *Tx, attempt *TxAttempt) error
+	FindLatestSequence(ctx context.Context, fromAddress common.Address, chainId *big.Int) (evmtypes.Nonce, error)
+	FindNoncesInUseFromAddress(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) ([]evmtypes.Nonce, error)
+	GetTxInProgress(ctx context.Context, fromAddress common.Address) (etx *Tx, err error)
+	FindTxsToResequence(ctx context.Context, fromAddress common.Address, minNonce evmtypes.Nonce, chainID *big.Int) (etxIDs []int64, minedIDs []int64, err error)
+	ResequenceTxs(ctx context.Context, etxIDs []int64) (requeuedIDs []int64, err error)
+	UpdateTxAttemptInProgressToBroadcast(ctx context.Context, etx *Tx, attempt TxAttempt, NewAttemptState txmgrtypes.TxAttemptState) error
+}
+
+type nonceGapRepairerClient interface {
+	PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error)
+	SendTransactionReturnCode(ctx context.Context, tx Tx, attempt TxAttempt, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error)
+}
+
+type nonceGapRepairerConfig interface {
+	Enabled() bool
+	Interval() time.Duration
+	Mode() toml.NonceGapRepairMode
+}
+
+// nonceGapRepairer detects nonces between the pending nonce on-chain and the highest nonce stored locally, that no
+// transaction is using. Such a gap prevents all transactions with a higher nonce from being included.
+// Gaps are either filled with zero-value self-transfers, or the unconfirmed transactions above the first gap are
+// abandoned and queued again as new transactions, to be broadcast with new nonces.
+type nonceGapRepairer struct {
+	lggr             logger.SugaredLogger
+	chainID          *big.Int
+	cfg              nonceGapRepairerConfig
+	transferGasLimit uint64
+	txStore          nonceGapRepairerTxStore
+	client           nonceGapRepairerClient
+	attemptBuilder   TxAttemptBuilder
+}
+
+func NewNonceGapRepairer(lggr logger.Logger, chainID *big.Int, cfg nonceGapRepairerConfig, transferGasLimit uint64, txStore nonceGapRepairerTxStore, client nonceGapRepairerClient, attemptBuilder TxAttemptBuilder) *nonceGapRepairer {
+	return &nonceGapRepairer{
+		lggr:             logger.Sugared(logger.Named(lggr, "NonceGapRepairer")),
+		chainID:          chainID,
+		cfg:              cfg,
+		transferGasLimit: transferGasLimit,
+		txStore:          txStore,
+		client:           client,
+		attemptBuilder:   attemptBuilder,
+	}
+}
+
+func (r *nonceGapRepairer) AutoRepairInterval() time.Duration {
+	if !r.cfg.Enabled() {
+		return 0
+	}
+	return r.cfg.Interval()
+}
+
+func (r *nonceGapRepairer) RepairSequenceGaps(ctx context.Context, addr common.Address, dryRun bool) (report SequenceGapReport, err error) {
+	report = SequenceGapReport{Address: addr, DryRun: dryRun}
+	if err = r.detectGaps(ctx, &report); err != nil || !report.HasGaps() {
+		return report, err
+	}
+	resequence := r.cfg.Mode() == toml.NonceGapRepairModeResequence
+	if resequence {
+		var minedIDs []int64
+		report.ResequencedTxIDs, minedIDs, err = r.txStore.FindTxsToResequence(ctx, addr, report.MissingSequences[0], r.chainID)
+		if err != nil {
+			return report, fmt.Errorf("failed to find transactions to resequence: %w", err)
+		}
+		for _, id := range minedIDs {
+			report.SkippedTxs = append(report.SkippedTxs, txmgrtypes.SkippedTx{ID: id, Reason: "has a receipt, it may already be included on-chain"})
+		}
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	inProgress, err := r.txStore.GetTxInProgress(ctx, addr)
+	if err != nil {
+		return report, fmt.Errorf("failed to check for in progress transaction: %w", err)
+	}
+	if inProgress != nil {
+		return report, fmt.Errorf("transaction %d is in progress for address %s, retry once it has been broadcast", inProgress.ID, addr)
+	}
+
+	toFill := report.MissingSequences
+	if resequence {
+		if len(report.ResequencedTxIDs) > 0 {
+			report.RequeuedTxIDs, err = r.txStore.ResequenceTxs(ctx, report.ResequencedTxIDs)
+			if err != nil {
+				return report, fmt.Errorf("failed to resequence transactions: %w", err)
+			}
+			r.lggr.Warnw("Abandoned transactions above nonce gap and queued them again", "address", addr, "etxIDs", report.ResequencedTxIDs, "requeuedEtxIDs", report.RequeuedTxIDs)
+		}
+		// Skipped transactions keep their nonces, so the gaps below them still have to be filled
+		remaining := SequenceGapReport{Address: addr}
+		if err = r.detectGaps(ctx, &remaining); err != nil {
+			return report, err
+		}
+		toFill = remaining.MissingSequences
+	}
+
+	for _, nonce := range toFill {
+		if err = r.sendSelfTransfer(ctx, addr, nonce); err != nil {
+			return report, fmt.Errorf("failed to fill nonce %d: %w", nonce, err)
+		}
+		report.FilledSequences = append(report.FilledSequences, nonce)
+	}
+	return report, nil
+}
+
+// detectGaps populates the report with the on-chain and stored nonces and the nonces missing in between
+func (r *nonceGapRepairer) detectGaps(ctx context.Context, report *SequenceGapReport) error {
+	var err error
+	report.NextSequenceOnChain, err = r.client.PendingSequenceAt(ctx, report.Address)
+	if err != nil {
+		return fmt.Errorf("failed to fetch pending nonce: %w", err)
+	}
+	highest, err := r.txStore.FindLatestSequence(ctx, report.Address, r.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to find highest stored nonce: %w", err)
+	}
+	report.HighestStoredSequence = &highest
+	if highest < report.NextSequenceOnChain {
+		return nil
+	}
+	inUse, err := r.txStore.FindNoncesInUseFromAddress(ctx, report.Address, report.NextSequenceOnChain, r.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to find nonces in use: %w", err)
+	}
+	report.MissingSequences = missingNonces(report.NextSequenceOnChain, highest, inUse)
+	return nil
+}
+
+// missingNonces returns the nonces in [from, to] not contained in the ascending inUse
+func missingNonces(from, to evmtypes.Nonce, inUse []evmtypes.Nonce) (missing []evmtypes.Nonce) {
+	i := 0
+	for n := from; n <= to; n++ {
+		for i < len(inUse) && inUse[i] < n {
+			i++
+		}
+		if i < len(inUse) && inUse[i] == n {
+			continue
+		}
+		missing = append(missing, n)
+	}
+	return missing
+}
+
+// sendSelfTransfer stores and broadcasts a zero-value transfer to the address itself using the given nonce.
+// The transaction is stored in_progress along with its attempt, so that the Broadcaster never sends it with another
+// nonce. It is handed over to the Confirmer afterward. If it could not be broadcast, it is left in_progress, so the
+// Broadcaster retries it.
+func (r *nonceGapRepairer) sendSelfTransfer(ctx context.Context, addr common.Address, nonce evmtypes.Nonce) error {
+	etx := Tx{
+		Sequence:       &nonce,
+		FromAddress:    addr,
+		ToAddress:      addr,
+		EncodedPayload: []byte{},
+		FeeLimit:       r.transferGasLimit,
+		ChainID:        r.chainID,
+		Priority:       txmgrtypes.TxPriorityCritical,
+	}
+	attempt, _, _, _, err := r.attemptBuilder.NewTxAttempt(ctx, etx, r.lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create attempt: %w", err)
+	}
+	if err = r.txStore.CreateTxInProgress(ctx, &etx, &attempt); err != nil {
+		return fmt.Errorf("failed to store transaction: %w", err)
+	}
+
+	lggr := etx.GetLogger(r.lggr.With("fee", attempt.TxFee))
+	lggr.Infow("Sending self-transfer to fill nonce gap", "nonce", nonce, "txHash", attempt.Hash)
+	errType, err := r.client.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+	if errType != commonclient.Successful && errType != commonclient.TransactionAlreadyKnown {
+		return fmt.Errorf("failed to broadcast transaction %d, it will be retried by the Broadcaster: %w", etx.ID, err)
+	}
+	now := time.Now()
+	etx.InitialBroadcastAt = &now
+	etx.BroadcastAt = &now
+	return r.txStore.UpdateTxAttemptInProgressToBroadcast(ctx, &etx, attempt, txmgrtypes.TxAttemptBroadcast)
+}
@@ -0,0 +1,264 @@
+package txmgr_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type testNonceGapRepairConfig struct {
+	enabled  bool
+	interval time.Duration
+	mode     toml.NonceGapRepairMode
+}
+
+func (t testNonceGapRepairConfig) Enabled() bool                 { return t.enabled }
+func (t testNonceGapRepairConfig) Interval() time.Duration       { return t.interval }
+func (t testNonceGapRepairConfig) Mode() toml.NonceGapRepairMode { return t.mode }
+
+func TestNonceGapRepairer_AutoRepairInterval(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	txStore := mocks.NewEvmTxStore(t)
+	txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+
+	disabled := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, testNonceGapRepairConfig{interval: time.Minute}, 21_000, txStore, txmClient, nil)
+	assert.Equal(t, time.Duration(0), disabled.AutoRepairInterval())
+
+	enabled := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, testNonceGapRepairConfig{enabled: true, interval: time.Minute}, 21_000, txStore, txmClient, nil)
+	assert.Equal(t, time.Minute, enabled.AutoRepairInterval())
+}
+
+func TestNonceGapRepairer_DryRun(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+	fromAddress := testutils.NewAddress()
+	cfg := testNonceGapRepairConfig{enabled: true, interval: time.Minute, mode: toml.NonceGapRepairModeSelfTransfer}
+
+	t.Run("reports no gaps if nothing is stored", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txStore := mocks.NewEvmTxStore(t)
+		repairer := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, cfg, 21_000, txStore, txmgr.NewEvmTxmClient(ethClient, nil), nil)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(5), nil).Once()
+		txStore.On("FindLatestSequence", mock.Anything, fromAddress, testutils.FixtureChainID).Return(evmtypes.Nonce(0), sql.ErrNoRows).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, true)
+		require.NoError(t, err)
+		assert.False(t, report.HasGaps())
+		assert.Equal(t, evmtypes.Nonce(5), report.NextSequenceOnChain)
+		assert.Nil(t, report.HighestStoredSequence)
+	})
+
+	t.Run("reports no gaps if all stored nonces are included on-chain", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txStore := mocks.NewEvmTxStore(t)
+		repairer := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, cfg, 21_000, txStore, txmgr.NewEvmTxmClient(ethClient, nil), nil)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(5), nil).Once()
+		txStore.On("FindLatestSequence", mock.Anything, fromAddress, testutils.FixtureChainID).Return(evmtypes.Nonce(4), nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, true)
+		require.NoError(t, err)
+		assert.False(t, report.HasGaps())
+		require.NotNil(t, report.HighestStoredSequence)
+		assert.Equal(t, evmtypes.Nonce(4), *report.HighestStoredSequence)
+	})
+
+	t.Run("reports missing nonces without repairing them", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txStore := mocks.NewEvmTxStore(t)
+		repairer := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, cfg, 21_000, txStore, txmgr.NewEvmTxmClient(ethClient, nil), nil)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(5), nil).Once()
+		txStore.On("FindLatestSequence", mock.Anything, fromAddress, testutils.FixtureChainID).Return(evmtypes.Nonce(10), nil).Once()
+		txStore.On("FindNoncesInUseFromAddress", mock.Anything, fromAddress, evmtypes.Nonce(5), testutils.FixtureChainID).
+			Return([]evmtypes.Nonce{6, 7, 9, 10}, nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, true)
+		require.NoError(t, err)
+		assert.True(t, report.HasGaps())
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []evmtypes.Nonce{5, 8}, report.MissingSequences)
+		assert.Empty(t, report.FilledSequences)
+		assert.Empty(t, report.ResequencedTxIDs)
+	})
+
+	t.Run("reports the transactions which would be resequenced and those skipped", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txStore := mocks.NewEvmTxStore(t)
+		cfg := testNonceGapRepairConfig{mode: toml.NonceGapRepairModeResequence}
+		repairer := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, cfg, 21_000, txStore, txmgr.NewEvmTxmClient(ethClient, nil), nil)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(5), nil).Once()
+		txStore.On("FindLatestSequence", mock.Anything, fromAddress, testutils.FixtureChainID).Return(evmtypes.Nonce(8), nil).Once()
+		txStore.On("FindNoncesInUseFromAddress", mock.Anything, fromAddress, evmtypes.Nonce(5), testutils.FixtureChainID).
+			Return([]evmtypes.Nonce{6, 7, 8}, nil).Once()
+		txStore.On("FindTxsToResequence", mock.Anything, fromAddress, evmtypes.Nonce(5), testutils.FixtureChainID).
+			Return([]int64{16, 18}, []int64{17}, nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, []evmtypes.Nonce{5}, report.MissingSequences)
+		assert.Equal(t, []int64{16, 18}, report.ResequencedTxIDs)
+		assert.Empty(t, report.RequeuedTxIDs)
+		require.Len(t, report.SkippedTxs, 1)
+		assert.Equal(t, int64(17), report.SkippedTxs[0].ID)
+	})
+}
+
+func TestNonceGapRepairer_Repair(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ge := evmcfg.EVM().GasEstimator()
+	lggr := logger.Test(t)
+
+	newRepairer := func(t *testing.T, mode toml.NonceGapRepairMode) (txmgr.TestEvmTxStore, *evmclimocks.Client, txmgr.SequenceGapRepairer, common.Address) {
+		txStore := cltest.NewTestTxStore(t, db)
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		estimator := gas.NewEvmFeeEstimator(lggr, func(lggr logger.Logger) gas.EvmEstimator {
+			return gas.NewFixedPriceEstimator(ge, nil, ge.BlockHistory(), lggr, nil)
+		}, ge.EIP1559DynamicFees(), ge, ethClient)
+		txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, estimator)
+		repairCfg := testNonceGapRepairConfig{enabled: true, interval: time.Minute, mode: mode}
+		repairer := txmgr.NewNonceGapRepairer(lggr, testutils.FixtureChainID, repairCfg, ge.LimitTransfer(), txStore, txmgr.NewEvmTxmClient(ethClient, nil), txBuilder)
+		return txStore, ethClient, repairer, fromAddress
+	}
+
+	t.Run("fills gaps with self-transfers", func(t *testing.T) {
+		ctx := tests.Context(t)
+		txStore, ethClient, repairer, fromAddress := newRepairer(t, toml.NonceGapRepairModeSelfTransfer)
+		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(1), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == 2 && *tx.To() == fromAddress && tx.Value().Sign() == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, false)
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{2}, report.MissingSequences)
+		assert.Equal(t, []evmtypes.Nonce{2}, report.FilledSequences)
+
+		etxs, err := txStore.FindTxesByFromAddressAndState(ctx, fromAddress, string(txmgrcommon.TxUnconfirmed))
+		require.NoError(t, err)
+		require.Len(t, etxs, 3)
+		var filled int
+		for _, etx := range etxs {
+			if *etx.Sequence == 2 {
+				filled++
+				assert.Equal(t, fromAddress, etx.ToAddress)
+				assert.Equal(t, ge.LimitTransfer(), etx.FeeLimit)
+			}
+		}
+		assert.Equal(t, 1, filled)
+	})
+
+	t.Run("abandons unconfirmed transactions above the gap and queues them again", func(t *testing.T) {
+		ctx := tests.Context(t)
+		txStore, ethClient, repairer, fromAddress := newRepairer(t, toml.NonceGapRepairModeResequence)
+		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+		etx4 := mustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 4, fromAddress)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(1), nil).Twice()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, false)
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{2}, report.MissingSequences)
+		assert.Empty(t, report.FilledSequences)
+		assert.Empty(t, report.SkippedTxs)
+		assert.Equal(t, []int64{etx3.ID, etx4.ID}, report.ResequencedTxIDs)
+		require.Len(t, report.RequeuedTxIDs, 2)
+
+		for i, id := range report.ResequencedTxIDs {
+			etx, err := txStore.FindTxWithAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+			assert.Nil(t, etx.Sequence)
+
+			requeued, err := txStore.FindTxWithAttempts(ctx, report.RequeuedTxIDs[i])
+			require.NoError(t, err)
+			assert.Equal(t, txmgrcommon.TxUnstarted, requeued.State)
+			assert.Equal(t, etx.EncodedPayload, requeued.EncodedPayload)
+		}
+	})
+
+	t.Run("skips transactions with receipts and fills the gaps below them", func(t *testing.T) {
+		ctx := tests.Context(t)
+		txStore, ethClient, repairer, fromAddress := newRepairer(t, toml.NonceGapRepairModeResequence)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+		etx3 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+		mustInsertEthReceipt(t, txStore, 42, utils.NewHash(), etx3.TxAttempts[0].Hash)
+		etx4 := mustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 4, fromAddress)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(1), nil).Twice()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethtypes.Transaction) bool {
+			return tx.Nonce() == 2 && *tx.To() == fromAddress
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, false)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{etx4.ID}, report.ResequencedTxIDs)
+		require.Len(t, report.SkippedTxs, 1)
+		assert.Equal(t, etx3.ID, report.SkippedTxs[0].ID)
+		assert.Contains(t, report.SkippedTxs[0].Reason, "receipt")
+		assert.Equal(t, []evmtypes.Nonce{2}, report.FilledSequences)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, etx3.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, evmtypes.Nonce(3), *etx.Sequence)
+	})
+
+	t.Run("refuses to repair while a transaction is in progress", func(t *testing.T) {
+		ctx := tests.Context(t)
+		txStore, ethClient, repairer, fromAddress := newRepairer(t, toml.NonceGapRepairModeSelfTransfer)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress)
+		mustInsertInProgressEthTxWithAttempt(t, txStore, 2, fromAddress)
+
+		ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+
+		report, err := repairer.RepairSequenceGaps(ctx, fromAddress, false)
+		require.ErrorContains(t, err, "is in progress")
+		assert.Equal(t, []evmtypes.Nonce{1}, report.MissingSequences)
+		assert.Empty(t, report.FilledSequences)
+	})
+}
@@ -57,6 +57,9 @@ type stuckTxDetector struct {
 
 	purgeBlockNumLock sync.RWMutex
 	purgeBlockNumMap  map[common.Address]int64 // Tracks the last block num a tx was purged for each from address if the PurgeOverflowTxs feature is enabled
+
+	stuckAddressesLock sync.RWMutex
+	stuckAddresses     map[common.Address]struct{} // Tracks the from addresses with a terminally stuck tx that was not purged yet
 }
 
 func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintype.ChainType, maxPrice *assets.Wei, cfg stuckTxDetectorConfig, gasEstimator stuckTxDetectorGasEstimator, txStore stuckTxDetectorTxStore, chainClient stuckTxDetectorClient) *stuckTxDetector {
@@ -74,6 +77,7 @@ func NewStuckTxDetector(lggr logger.Logger, chainID *big.Int, chainType chaintyp
 		chainClient:      chainClient,
 		httpClient:       httpClient,
 		purgeBlockNumMap: make(map[common.Address]int64),
+		stuckAddresses:   make(map[common.Address]struct{}),
 	}
 }
 
@@ -116,34 +120,68 @@ func (d *stuckTxDetector) DetectStuckTransactions(ctx context.Context, enabledAd
 	if !d.cfg.Enabled() {
 		return nil, nil
 	}
-	txs, err := d.FindUnconfirmedTxWithLowestNonce(ctx, enabledAddresses)
+	txs, purgingAddresses, err := d.findUnconfirmedTxWithLowestNonce(ctx, enabledAddresses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get list of transactions waiting confirmations with lowest nonce for distinct from addresses: %w", err)
 	}
 	// No transactions found
 	if len(txs) == 0 {
+		d.setStuckAddresses(purgingAddresses, nil)
 		return nil, nil
 	}
 
+	var stuckTxs []Tx
 	switch d.chainType {
 	case chaintype.ChainScroll:
-		return d.detectStuckTransactionsScroll(ctx, txs)
+		stuckTxs, err = d.detectStuckTransactionsScroll(ctx, txs)
 	case chaintype.ChainZkEvm, chaintype.ChainXLayer:
-		return d.detectStuckTransactionsZkEVM(ctx, txs)
+		stuckTxs, err = d.detectStuckTransactionsZkEVM(ctx, txs)
 	case chaintype.ChainZircuit:
-		return d.detectStuckTransactionsZircuit(ctx, txs, blockNum)
+		stuckTxs, err = d.detectStuckTransactionsZircuit(ctx, txs, blockNum)
 	default:
-		return d.detectStuckTransactionsHeuristic(ctx, txs, blockNum)
+		stuckTxs, err = d.detectStuckTransactionsHeuristic(ctx, txs, blockNum)
 	}
+	if err != nil {
+		return nil, err
+	}
+	d.setStuckAddresses(purgingAddresses, stuckTxs)
+	return stuckTxs, nil
+}
+
+// Replaces the set of stuck addresses with the addresses that have a purge in progress and the addresses of newly detected stuck txs
+func (d *stuckTxDetector) setStuckAddresses(purgingAddresses []common.Address, stuckTxs []Tx) {
+	d.stuckAddressesLock.Lock()
+	defer d.stuckAddressesLock.Unlock()
+	d.stuckAddresses = make(map[common.Address]struct{}, len(purgingAddresses)+len(stuckTxs))
+	for _, address := range purgingAddresses {
+		d.stuckAddresses[address] = struct{}{}
+	}
+	for _, tx := range stuckTxs {
+		d.stuckAddresses[tx.FromAddress] = struct{}{}
+	}
+}
+
+// HasStuckTransactions returns true if the latest detection found a terminally stuck tx for the address that is not purged yet
+func (d *stuckTxDetector) HasStuckTransactions(fromAddress common.Address) bool {
+	d.stuckAddressesLock.RLock()
+	defer d.stuckAddressesLock.RUnlock()
+	_, ok := d.stuckAddresses[fromAddress]
+	return ok
 }
 
 // Finds the lowest nonce Unconfirmed transaction for each enabled address
 // Only the earliest transaction can be considered terminally stuck. All others may be valid and just stuck behind the nonce
 func (d *stuckTxDetector) FindUnconfirmedTxWithLowestNonce(ctx context.Context, enabledAddresses []common.Address) ([]Tx, error) {
+	stuckTxs, _, err := d.findUnconfirmedTxWithLowestNonce(ctx, enabledAddresses)
+	return stuckTxs, err
+}
+
+// Also returns the from addresses whose lowest nonce tx is already marked for purge
+func (d *stuckTxDetector) findUnconfirmedTxWithLowestNonce(ctx context.Context, enabledAddresses []common.Address) (stuckTxs []Tx, purgingAddresses []common.Address, err error) {
 	// Loads attempts within tx
 	txs, err := d.txStore.FindTxsByStateAndFromAddresses(ctx, enabledAddresses, txmgr.TxUnconfirmed, d.chainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve unconfirmed transactions for enabled addresses: %w", err)
+		return nil, nil, fmt.Errorf("failed to retrieve unconfirmed transactions for enabled addresses: %w", err)
 	}
 	// Stores the lowest nonce tx found in the query results for each from address
 	lowestNonceTxMap := make(map[common.Address]Tx)
@@ -156,7 +194,6 @@ func (d *stuckTxDetector) FindUnconfirmedTxWithLowestNonce(ctx context.Context,
 	}
 
 	// Build list of potentially stuck tx but exclude any that are already marked for purge or have non-broadcasted attempts
-	var stuckTxs []Tx
 	for _, tx := range lowestNonceTxMap {
 		if len(tx.TxAttempts) == 0 {
 			d.lggr.AssumptionViolationw("encountered an unconfirmed transaction without an attempt", "tx", tx)
@@ -176,12 +213,14 @@ func (d *stuckTxDetector) FindUnconfirmedTxWithLowestNonce(ctx context.Context,
 				break
 			}
 		}
-		if !foundPurgeAttempt && !foundNonBroadcastAttempt {
+		if foundPurgeAttempt {
+			purgingAddresses = append(purgingAddresses, tx.FromAddress)
+		} else if !foundNonBroadcastAttempt {
 			stuckTxs = append(stuckTxs, tx)
 		}
 	}
 
-	return stuckTxs, nil
+	return stuckTxs, purgingAddresses, nil
 }
 
 // Uses a heuristic to determine a stuck transaction potentially due to overflow
@@ -507,6 +546,10 @@ func (d *stuckTxDetector) SetPurgeBlockNum(fromAddress common.Address, blockNum
 	d.purgeBlockNumLock.Lock()
 	defer d.purgeBlockNumLock.Unlock()
 	d.purgeBlockNumMap[fromAddress] = blockNum
+
+	d.stuckAddressesLock.Lock()
+	defer d.stuckAddressesLock.Unlock()
+	delete(d.stuckAddresses, fromAddress)
 }
 
 func (d *stuckTxDetector) StuckTxFatalError() string {
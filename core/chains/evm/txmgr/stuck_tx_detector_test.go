@@ -279,6 +279,31 @@ func TestStuckTxDetector_DetectStuckTransactionsHeuristic(t *testing.T) {
 		require.Len(t, txs, 1)
 	})
 
+	t.Run("reports address as stuck until the purge is confirmed", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		enabledAddresses := []common.Address{fromAddress}
+		mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, autoPurgeMinAttempts, blockNum-int64(autoPurgeThreshold)+int64(autoPurgeMinAttempts-1), marketGasPrice.Add(oneGwei))
+		require.False(t, stuckTxDetector.HasStuckTransactions(fromAddress))
+
+		txs, err := stuckTxDetector.DetectStuckTransactions(ctx, enabledAddresses, blockNum)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.True(t, stuckTxDetector.HasStuckTransactions(fromAddress))
+
+		stuckTxDetector.SetPurgeBlockNum(fromAddress, blockNum)
+		require.False(t, stuckTxDetector.HasStuckTransactions(fromAddress))
+	})
+
+	t.Run("reports address with a purge in progress as stuck", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+		mustInsertUnconfirmedEthTxWithBroadcastPurgeAttempt(t, txStore, 0, fromAddress)
+
+		txs, err := stuckTxDetector.DetectStuckTransactions(ctx, []common.Address{fromAddress}, blockNum)
+		require.NoError(t, err)
+		require.Len(t, txs, 0)
+		require.True(t, stuckTxDetector.HasStuckTransactions(fromAddress))
+	})
+
 	t.Run("detects stuck transaction with empty BroadcastBeforeBlockNum in attempts will be skipped without panic", func(t *testing.T) {
 		_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
 		enabledAddresses := []common.Address{fromAddress}
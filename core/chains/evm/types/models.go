@@ -46,6 +46,8 @@ type Head struct {
 	Difficulty       *big.Int
 	TotalDifficulty  *big.Int
 	IsFinalized      atomic.Bool
+	// ExcessBlobGas is only set for chains that support EIP-4844 and is not persisted
+	ExcessBlobGas *uint64 `db:"-"`
 }
 
 var _ commontypes.Head[common.Hash] = &Head{}
@@ -237,17 +239,18 @@ func (h *Head) AsSlice(k int) (heads []*Head) {
 
 func (h *Head) UnmarshalJSON(bs []byte) error {
 	type head struct {
-		Hash             common.Hash    `json:"hash"`
-		Number           *hexutil.Big   `json:"number"`
-		ParentHash       common.Hash    `json:"parentHash"`
-		Timestamp        hexutil.Uint64 `json:"timestamp"`
-		L1BlockNumber    *hexutil.Big   `json:"l1BlockNumber"`
-		BaseFeePerGas    *hexutil.Big   `json:"baseFeePerGas"`
-		ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
-		TransactionsRoot common.Hash    `json:"transactionsRoot"`
-		StateRoot        common.Hash    `json:"stateRoot"`
-		Difficulty       *hexutil.Big   `json:"difficulty"`
-		TotalDifficulty  *hexutil.Big   `json:"totalDifficulty"`
+		Hash             common.Hash     `json:"hash"`
+		Number           *hexutil.Big    `json:"number"`
+		ParentHash       common.Hash     `json:"parentHash"`
+		Timestamp        hexutil.Uint64  `json:"timestamp"`
+		L1BlockNumber    *hexutil.Big    `json:"l1BlockNumber"`
+		BaseFeePerGas    *hexutil.Big    `json:"baseFeePerGas"`
+		ReceiptsRoot     common.Hash     `json:"receiptsRoot"`
+		TransactionsRoot common.Hash     `json:"transactionsRoot"`
+		StateRoot        common.Hash     `json:"stateRoot"`
+		Difficulty       *hexutil.Big    `json:"difficulty"`
+		TotalDifficulty  *hexutil.Big    `json:"totalDifficulty"`
+		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas"`
 	}
 
 	var jsonHead head
@@ -274,6 +277,7 @@ func (h *Head) UnmarshalJSON(bs []byte) error {
 	h.StateRoot = jsonHead.StateRoot
 	h.Difficulty = jsonHead.Difficulty.ToInt()
 	h.TotalDifficulty = jsonHead.TotalDifficulty.ToInt()
+	h.ExcessBlobGas = (*uint64)(jsonHead.ExcessBlobGas)
 	return nil
 }
 
@@ -288,6 +292,7 @@ func (h *Head) MarshalJSON() ([]byte, error) {
 		StateRoot        *common.Hash    `json:"stateRoot,omitempty"`
 		Difficulty       *hexutil.Big    `json:"difficulty,omitempty"`
 		TotalDifficulty  *hexutil.Big    `json:"totalDifficulty,omitempty"`
+		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas,omitempty"`
 	}
 
 	var jsonHead head
@@ -313,6 +318,7 @@ func (h *Head) MarshalJSON() ([]byte, error) {
 	}
 	jsonHead.Difficulty = (*hexutil.Big)(h.Difficulty)
 	jsonHead.TotalDifficulty = (*hexutil.Big)(h.TotalDifficulty)
+	jsonHead.ExcessBlobGas = (*hexutil.Uint64)(h.ExcessBlobGas)
 	return json.Marshal(jsonHead)
 }
 
@@ -367,6 +367,16 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 				StateRoot:        common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
 			},
 		},
+		{"eip4844",
+			`{"number":"0x100","hash":"0x41800b5c3f1717687d85fc9018faac0a6e90b39deaa0b99e7fe4fe796ddeb26a","parentHash":"0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d","timestamp":"0x58318da2","baseFeePerGas":"0x7","blobGasUsed":"0x20000","excessBlobGas":"0x4b0000"}`,
+			&evmtypes.Head{
+				Hash:          common.HexToHash("0x41800b5c3f1717687d85fc9018faac0a6e90b39deaa0b99e7fe4fe796ddeb26a"),
+				Number:        0x100,
+				ParentHash:    common.HexToHash("0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d"),
+				Timestamp:     time.Unix(0x58318da2, 0).UTC(),
+				ExcessBlobGas: ptr[uint64](0x4b0000),
+			},
+		},
 		{"not found",
 			`null`,
 			&evmtypes.Head{},
@@ -387,6 +397,7 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 			assert.Equal(t, test.expected.ReceiptsRoot, head.ReceiptsRoot)
 			assert.Equal(t, test.expected.TransactionsRoot, head.TransactionsRoot)
 			assert.Equal(t, test.expected.StateRoot, head.StateRoot)
+			assert.Equal(t, test.expected.ExcessBlobGas, head.ExcessBlobGas)
 		})
 	}
 }
@@ -413,6 +424,13 @@ func TestHead_MarshalJSON(t *testing.T) {
 			&evmtypes.Head{},
 			`{"number":"0x0"}`,
 		},
+		{"eip4844",
+			&evmtypes.Head{
+				Number:        0x100,
+				ExcessBlobGas: ptr[uint64](0x4b0000),
+			},
+			`{"number":"0x100","excessBlobGas":"0x4b0000"}`,
+		},
 	}
 
 	for _, test := range tests {
@@ -1205,3 +1223,5 @@ func mustHexToBig(t *testing.T, hx string) *big.Int {
 	require.NoError(t, err)
 	return n
 }
+
+func ptr[T any](t T) *T { return &t }
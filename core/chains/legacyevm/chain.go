@@ -372,6 +372,10 @@ func (c *chain) HealthReport() map[string]error {
 	services.CopyHealth(report, c.headBroadcaster.HealthReport())
 	services.CopyHealth(report, c.headTracker.HealthReport())
 	services.CopyHealth(report, c.logBroadcaster.HealthReport())
+	// mocks and single node clients do not report health
+	if hr, ok := c.client.(interface{ HealthReport() map[string]error }); ok {
+		services.CopyHealth(report, hr.HealthReport())
+	}
 
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
@@ -163,6 +163,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for the node's configuration",
 			Subcommands: initRemoteConfigSubCmds(s),
 		},
+		{
+			Name:        "fragments",
+			Usage:       "Commands for managing reusable pipeline fragments",
+			Subcommands: initFragmentSubCmds(s),
+		},
 		{
 			Name:   "health",
 			Usage:  "Prints a health report",
@@ -313,6 +318,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for inspecting and simulating workflows",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -2,11 +2,14 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -50,16 +53,46 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyEndpoints summarizes the health of the endpoints
+func (p *BridgePresenter) FriendlyEndpoints() string {
+	var healthy int
+	for _, e := range p.Endpoints {
+		if e.Status == string(bridges.EndpointStatusHealthy) {
+			healthy++
+		}
+	}
+	return fmt.Sprintf("%d/%d healthy", healthy, len(p.Endpoints))
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
-	table.Append([]string{
+	headers := []string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Auth Scheme"}
+	row := []string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
-	})
+		p.AuthScheme,
+	}
+	// the generated HMAC secret is only provided when creating a bridge
+	if p.HMACSecret != "" {
+		headers = append(headers, "HMAC Secret")
+		row = append(row, p.HMACSecret)
+	}
+	table := rt.newTable(headers)
+	table.Append(row)
 	render("Bridge", table)
+
+	endpoints := rt.newTable([]string{"URL", "Status", "Latency", "Checked At", "Error"})
+	for _, e := range p.Endpoints {
+		var latency, checkedAt string
+		if e.CheckedAt != nil {
+			latency = (time.Duration(e.LatencyMS) * time.Millisecond).String()
+			checkedAt = e.CheckedAt.String()
+		}
+		endpoints.Append([]string{e.URL, e.Status, latency, checkedAt, e.Error})
+	}
+	render("Endpoints ("+p.SelectionPolicy+")", endpoints)
 	return nil
 }
 
@@ -67,12 +100,13 @@ type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Endpoints"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			p.FriendlyEndpoints(),
 		})
 	}
 
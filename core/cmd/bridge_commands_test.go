@@ -36,7 +36,12 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			URL:           url,
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
-			CreatedAt:     createdAt,
+			AuthScheme:    "hmac",
+			Endpoints: []presenters.BridgeEndpointResource{
+				{URL: url, Status: "healthy", LatencyMS: 12, CheckedAt: &createdAt},
+				{URL: "http://fallback.example.com", Status: "unhealthy", CheckedAt: &createdAt, Error: "connection refused"},
+			},
+			CreatedAt: createdAt,
 		},
 	}
 
@@ -48,6 +53,9 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "hmac")
+	assert.Contains(t, output, "12ms")
+	assert.Contains(t, output, "connection refused")
 
 	// Render many resources
 	buffer.Reset()
@@ -58,6 +66,7 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, name)
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
+	assert.Contains(t, output, "1/2 healthy")
 	assert.NotContains(t, output, outgoingToken)
 }
 
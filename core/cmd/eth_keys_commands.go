@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -115,6 +116,27 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 					},
 				},
 			},
+			{
+				Name:   "resync",
+				Usage:  "Detect and repair nonce gaps for an EVM key on the given chain",
+				Action: s.ResyncEVMKey,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "address",
+						Usage:    "address of the key",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "evm-chain-id, evmChainID",
+						Usage:    "chain ID of the key",
+						Required: true,
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only report the nonce gaps, without repairing them",
+					},
+				},
+			},
 		},
 	}
 }
@@ -401,3 +423,77 @@ func (s *Shell) UpdateChainEVMKey(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "🔑 Updated ETH key")
 }
+
+type EthKeyResyncPresenter struct {
+	presenters.ETHKeyResyncResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EthKeyResyncPresenter) RenderTable(rt RendererTable) error {
+	highest := "None"
+	if p.HighestStoredNonce != nil {
+		highest = strconv.FormatInt(*p.HighestStoredNonce, 10)
+	}
+	table := rt.newTable([]string{"Address", "EVM Chain ID", "Next Nonce On-Chain", "Highest Stored Nonce", "Missing Nonces", "Filled Nonces", "Resequenced Txs", "Dry Run"})
+	table.Append([]string{
+		p.Address,
+		p.EVMChainID.String(),
+		strconv.FormatInt(p.NextNonceOnChain, 10),
+		highest,
+		joinNonces(p.MissingNonces),
+		joinNonces(p.FilledNonces),
+		strings.Join(p.ResequencedTxIDs, ", "),
+		strconv.FormatBool(p.DryRun),
+	})
+	render("Nonce Resync", table)
+
+	if len(p.RequeuedTxIDs) > 0 {
+		requeued := rt.newTable([]string{"Requeued Txs"})
+		requeued.Append([]string{strings.Join(p.RequeuedTxIDs, ", ")})
+		render("Requeued Transactions", requeued)
+	}
+	if len(p.SkippedTxs) > 0 {
+		skipped := rt.newTable([]string{"ID", "Reason"})
+		for _, tx := range p.SkippedTxs {
+			skipped.Append([]string{tx.ID, tx.Reason})
+		}
+		render("Skipped Transactions", skipped)
+	}
+	return nil
+}
+
+func joinNonces(nonces []int64) string {
+	s := make([]string, len(nonces))
+	for i, n := range nonces {
+		s[i] = strconv.FormatInt(n, 10)
+	}
+	return strings.Join(s, ", ")
+}
+
+// ResyncEVMKey detects nonce gaps for an EVM key and, unless --dry-run is set, repairs them
+func (s *Shell) ResyncEVMKey(c *cli.Context) (err error) {
+	resyncURL := url.URL{Path: "/v2/keys/evm/resync"}
+	query := resyncURL.Query()
+	query.Set("address", c.String("address"))
+	query.Set("evmChainID", c.String("evmChainID"))
+	if c.Bool("dry-run") {
+		query.Set("dryRun", "true")
+	}
+
+	resyncURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), resyncURL.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error resyncing key: %w", httpError(resp)))
+	}
+
+	return s.renderAPIResponse(resp, &EthKeyResyncPresenter{})
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -11,14 +13,20 @@ type EVMNodePresenter struct {
 
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.Name, p.ChainID, p.State, p.Config}
+	score := ""
+	if p.Score != nil {
+		score = fmt.Sprintf("%.2f", *p.Score)
+	}
+	return []string{p.Name, p.ChainID, p.State, score, p.Config}
 }
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Score", "Config"}
+
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +42,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
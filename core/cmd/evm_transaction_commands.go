@@ -62,6 +62,11 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "simulate",
+				Usage:  "Simulate a pending Ethereum Transaction with the given ID against the latest state, without broadcasting it",
+				Action: s.SimulateTransaction,
+			},
 		},
 	}
 }
@@ -131,6 +136,49 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type EthTxSimulationPresenter struct {
+	JAID
+	presenters.EthTxSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *EthTxSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"From", "To", "State", "Reverted", "Gas Limit", "Gas Used", "Revert Reason", "Error"})
+	table.Append([]string{
+		p.From.Hex(),
+		p.To.Hex(),
+		p.State,
+		fmt.Sprint(p.Reverted),
+		p.GasLimit,
+		p.GasUsed,
+		p.RevertReason,
+		p.Error,
+	})
+
+	render(fmt.Sprintf("Ethereum Transaction Simulation %v", p.JAID.ID), table)
+	return nil
+}
+
+// SimulateTransaction executes the transaction with the given ID against the latest state of the chain
+func (s *Shell) SimulateTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the transaction"))
+	}
+	id := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+id+"/simulate", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxSimulationPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
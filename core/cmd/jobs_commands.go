@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -50,6 +52,22 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "replay",
+			Usage:  "Replay a run of a job with captureResponses enabled from its captured responses, and compare the results",
+			Action: s.ReplayPipelineRun,
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of a job's pipeline locally, with the results of http, bridge and ethcall tasks taken from fixtures",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "path to a JSON file of recorded task results by task ID and run variables",
+				},
+			},
+		},
 	}
 }
 
@@ -294,6 +312,151 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// JobSimulationPresenter wraps the JSONAPI Pipeline Simulation Resource and adds rendering functionality
+type JobSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Value", "Error", "Duration"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			stringOrEmpty(tr.Output),
+			stringOrEmpty(tr.Error),
+			tr.Duration,
+		})
+	}
+	render(fmt.Sprintf("Pipeline Simulation (%s)", p.State), table)
+
+	outputs := rt.newTable([]string{"Output", "Fatal Error"})
+	for i := range p.Outputs {
+		var fatalErr *string
+		if i < len(p.FatalErrors) {
+			fatalErr = p.FatalErrors[i]
+		}
+		outputs.Append([]string{stringOrEmpty(p.Outputs[i]), stringOrEmpty(fatalErr)})
+	}
+	render("Outputs", outputs)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// SimulateJob runs the pipeline of a job spec in-memory, without a node, database or network access.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	spec, err := job.SimulationSpec(tomlString)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to parse TOML"))
+	}
+
+	var fixtures pipeline.SimulationFixtures
+	if path := c.String("fixtures"); path != "" {
+		b, rerr := os.ReadFile(path)
+		if rerr != nil {
+			return s.errorOut(errors.Wrapf(rerr, "error reading fixtures from file '%s'", path))
+		}
+		if fixtures, err = pipeline.ParseSimulationFixtures(b); err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	run, err := pipeline.Simulate(s.ctx(), s.Config.JobPipeline(), s.Logger, spec, fixtures)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	if err = s.Render(&JobSimulationPresenter{PipelineSimulationResource: presenters.NewPipelineSimulationResource(*run, s.Logger)}); err != nil {
+		return s.errorOut(err)
+	}
+	if run.HasFatalErrors() {
+		return s.errorOut(errors.New("simulated run finished with fatal errors"))
+	}
+	return nil
+}
+
+// JobReplayPresenter wraps the JSONAPI Pipeline Replay Resource and adds rendering functionality
+type JobReplayPresenter struct {
+	JAID
+	presenters.PipelineReplayResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobReplayPresenter) RenderTable(rt RendererTable) error {
+	replayed := make(map[string]presenters.PipelineSimulationTaskRunResource, len(p.Replay.TaskRuns))
+	for _, tr := range p.Replay.TaskRuns {
+		replayed[tr.DotID] = tr
+	}
+	table := rt.newTable([]string{"Task", "Type", "Original", "Replay", "Match"})
+	for _, original := range p.Original.TaskRuns {
+		replay := replayed[original.DotID]
+		o, r := taskRunResult(original), taskRunResult(replay)
+		table.Append([]string{original.DotID, string(original.Type), o, r, fmt.Sprintf("%t", o == r)})
+	}
+	render(fmt.Sprintf("Pipeline Replay of Run %s (%s, replay %s)", p.ID, p.Original.State, p.Replay.State), table)
+
+	outputs := rt.newTable([]string{"Original Output", "Replay Output"})
+	for i := 0; i < len(p.Original.Outputs) || i < len(p.Replay.Outputs); i++ {
+		var o, r *string
+		if i < len(p.Original.Outputs) {
+			o = p.Original.Outputs[i]
+		}
+		if i < len(p.Replay.Outputs) {
+			r = p.Replay.Outputs[i]
+		}
+		outputs.Append([]string{stringOrEmpty(o), stringOrEmpty(r)})
+	}
+	if p.Diverged {
+		render("Outputs (diverged)", outputs)
+	} else {
+		render("Outputs (match)", outputs)
+	}
+	return nil
+}
+
+func taskRunResult(tr presenters.PipelineSimulationTaskRunResource) string {
+	if tr.Error != nil {
+		return "error: " + *tr.Error
+	}
+	return stringOrEmpty(tr.Output)
+}
+
+// ReplayPipelineRun re-executes a stored pipeline run on the node from the
+// responses captured when it ran, and renders the original and replayed results
+func (s *Shell) ReplayPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the run id to replay"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/runs/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var replay JobReplayPresenter
+	return s.renderAPIResponse(resp, &replay)
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
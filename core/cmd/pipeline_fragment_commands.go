@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initFragmentSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "create",
+			Usage:  "Create a new version of a pipeline fragment from a DOT file, and list the jobs using it",
+			Action: s.CreatePipelineFragment,
+		},
+		{
+			Name:   "destroy",
+			Usage:  "Destroy all versions of a pipeline fragment which is not used by any job",
+			Action: s.RemovePipelineFragment,
+		},
+		{
+			Name:   "list",
+			Usage:  "List the latest version of all pipeline fragments",
+			Action: s.IndexPipelineFragments,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "show",
+			Usage:  "Show a pipeline fragment and the jobs using it",
+			Action: s.ShowPipelineFragment,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "version",
+					Usage: "version of the fragment to show, defaults to the latest",
+				},
+			},
+		},
+	}
+}
+
+type PipelineFragmentPresenter struct {
+	JAID
+	presenters.PipelineFragmentResource
+}
+
+// FriendlyJobIDs converts the job IDs to a comma separated string
+func (p *PipelineFragmentPresenter) FriendlyJobIDs() string {
+	ids := make([]string, len(p.JobIDs))
+	for i, id := range p.JobIDs {
+		ids[i] = strconv.FormatInt(int64(id), 10)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineFragmentPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Version", "Jobs", "Created"})
+	table.Append([]string{
+		p.Name,
+		strconv.FormatInt(int64(p.Version), 10),
+		p.FriendlyJobIDs(),
+		p.CreatedAt.String(),
+	})
+	render("Pipeline Fragment", table)
+	fmt.Println(p.DotDagSource)
+	return nil
+}
+
+type PipelineFragmentPresenters []PipelineFragmentPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineFragmentPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Version", "Created"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.Name,
+			strconv.FormatInt(int64(p.Version), 10),
+			p.CreatedAt.String(),
+		})
+	}
+
+	render("Pipeline Fragments", table)
+	return nil
+}
+
+// IndexPipelineFragments returns the latest version of all fragments.
+func (s *Shell) IndexPipelineFragments(c *cli.Context) (err error) {
+	return s.getPage("/v2/pipeline/fragments", c.Int("page"), &PipelineFragmentPresenters{})
+}
+
+// ShowPipelineFragment returns the given fragment and the jobs using it.
+func (s *Shell) ShowPipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the fragment to be shown"))
+	}
+	path := "/v2/pipeline/fragments/" + url.PathEscape(c.Args().First())
+	if v := c.Int("version"); v != 0 {
+		path += "?version=" + strconv.Itoa(v)
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// CreatePipelineFragment stores the DOT file as the next version of the named fragment.
+func (s *Shell) CreatePipelineFragment(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the fragment and the path of its DOT file"))
+	}
+	source, err := os.ReadFile(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	b, err := json.Marshal(web.PipelineFragmentRequest{Name: c.Args().First(), DotDagSource: string(source)})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/fragments", bytes.NewReader(b))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{}, "Pipeline fragment created, the jobs listed will use it if they do not pin a version")
+}
+
+// RemovePipelineFragment removes all versions of a fragment by name.
+func (s *Shell) RemovePipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the fragment to be removed"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/pipeline/fragments/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
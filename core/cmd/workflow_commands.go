@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting the executions of workflows",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, most recent first",
+					Action: s.IndexWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list executions of this workflow",
+						},
+						cli.StringFlag{
+							Name:  "status",
+							Usage: "only list executions with this status, one of started, errored, timeout, completed, completed_early_exit or cancelled",
+						},
+						cli.StringFlag{
+							Name:  "created-after",
+							Usage: "only list executions created at or after this RFC3339 time",
+						},
+						cli.StringFlag{
+							Name:  "created-before",
+							Usage: "only list executions created before this RFC3339 time",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a workflow execution with the inputs, outputs and errors of its steps",
+					Action: s.ShowWorkflowExecution,
+				},
+				{
+					Name:   "cancel",
+					Usage:  "Cancel a running workflow execution, which stops dispatching its remaining steps",
+					Action: s.CancelWorkflowExecution,
+				},
+			},
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a workflow job spec locally, with its triggers emitting events from a file and its capabilities stubbed",
+			Action: s.SimulateWorkflow,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "events, e",
+					Usage: "path to a JSON file of the events emitted by the triggers, each with an optional triggerID and id, and outputs",
+				},
+				cli.StringFlag{
+					Name:  "fixtures, f",
+					Usage: "path to a JSON file of the outputs or error of capabilities by ID; capabilities without fixture return their inputs",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum duration of the simulation",
+					Value: time.Minute,
+				},
+			},
+		},
+	}
+}
+
+type WorkflowExecutionPresenter struct {
+	JAID
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow presents the WorkflowExecutionPresenter as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	var createdAt, finishedAt string
+	if p.CreatedAt != nil {
+		createdAt = p.CreatedAt.String()
+	}
+	if p.FinishedAt != nil {
+		finishedAt = p.FinishedAt.String()
+	}
+	return []string{p.JAID.ID, p.WorkflowID, p.Status, strconv.Itoa(len(p.Steps)), createdAt, finishedAt}
+}
+
+var workflowExecutionHeaders = []string{"ID", "Workflow ID", "Status", "Steps", "Created", "Finished"}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	table.Append(p.ToRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Ref", "Status", "Attempts", "Inputs", "Outputs", "Error"})
+	for _, step := range p.Steps {
+		steps.Append([]string{step.Ref, step.Status, strconv.Itoa(step.Attempts), stringOrEmpty(step.Inputs), stringOrEmpty(step.Outputs), stringOrEmpty(step.Error)})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(workflowExecutionHeaders)
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// IndexWorkflowExecutions lists workflow executions, optionally filtered by
+// workflow, status and creation time.
+func (s *Shell) IndexWorkflowExecutions(c *cli.Context) (err error) {
+	_, err = web.ParseWorkflowExecutionFilter(c.String("workflow-id"), c.String("status"), c.String("created-after"), c.String("created-before"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	q := url.Values{}
+	for param, flag := range map[string]string{
+		"workflowID":    "workflow-id",
+		"status":        "status",
+		"createdAfter":  "created-after",
+		"createdBefore": "created-before",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	return s.getPage("/v2/workflows/executions?"+q.Encode(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution shows a workflow execution and its steps.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be shown"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// CancelWorkflowExecution cancels a running workflow execution.
+func (s *Shell) CancelWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the ID of the workflow execution to be cancelled"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First())+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// WorkflowSimulationPresenter presents the step trace and the executions of a simulated workflow.
+type WorkflowSimulationPresenter struct {
+	Trace      []WorkflowSimulationStep    `json:"trace"`
+	Executions WorkflowExecutionPresenters `json:"executions"`
+}
+
+// WorkflowSimulationStep is an update of a step in the trace of a simulated workflow.
+type WorkflowSimulationStep struct {
+	ExecutionID string `json:"executionID"`
+	presenters.WorkflowExecutionStepResource
+}
+
+// NewWorkflowSimulationPresenter constructs a new WorkflowSimulationPresenter.
+func NewWorkflowSimulationPresenter(result workflows.SimulationResult) *WorkflowSimulationPresenter {
+	p := &WorkflowSimulationPresenter{
+		Trace:      []WorkflowSimulationStep{},
+		Executions: WorkflowExecutionPresenters{},
+	}
+	for _, e := range presenters.NewWorkflowExecutionResources(result.Executions) {
+		p.Executions = append(p.Executions, WorkflowExecutionPresenter{JAID: JAID{ID: e.ID}, WorkflowExecutionResource: e})
+	}
+	for _, step := range result.Trace {
+		p.Trace = append(p.Trace, WorkflowSimulationStep{
+			ExecutionID:                   step.ExecutionID,
+			WorkflowExecutionStepResource: presenters.NewWorkflowExecutionStepResource(step),
+		})
+	}
+	return p
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowSimulationPresenter) RenderTable(rt RendererTable) error {
+	trace := rt.newTable([]string{"#", "Execution ID", "Ref", "Status", "Attempts", "Inputs", "Outputs", "Error"})
+	for i, step := range p.Trace {
+		trace.Append([]string{strconv.Itoa(i + 1), step.ExecutionID, step.Ref, step.Status, strconv.Itoa(step.Attempts), stringOrEmpty(step.Inputs), stringOrEmpty(step.Outputs), stringOrEmpty(step.Error)})
+	}
+	render("Workflow Simulation Trace", trace)
+
+	executions := rt.newTable(workflowExecutionHeaders)
+	for _, e := range p.Executions {
+		executions.Append(e.ToRow())
+	}
+	render("Workflow Executions", executions)
+	return nil
+}
+
+// SimulateWorkflow runs a workflow job spec in-memory, without a node, database or network access.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateWorkflow(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+	if !c.IsSet("events") {
+		return s.errorOut(errors.New("must pass the path to a file of trigger events with --events"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	jb, err := workflows.ValidatedWorkflowJobSpec(s.ctx(), tomlString)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	b, err := os.ReadFile(c.String("events"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error reading events from file '%s': %w", c.String("events"), err))
+	}
+	events, err := workflows.ParseSimulatedTriggerEvents(b)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	var fixtures map[string]workflows.CapabilityFixture
+	if path := c.String("fixtures"); path != "" {
+		if b, err = os.ReadFile(path); err != nil {
+			return s.errorOut(fmt.Errorf("error reading fixtures from file '%s': %w", path, err))
+		}
+		if fixtures, err = workflows.ParseCapabilityFixtures(b); err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx(), c.Duration("timeout"))
+	defer cancel()
+	result, simErr := workflows.Simulate(ctx, workflows.SimulationConfig{
+		Lggr:     s.Logger,
+		Spec:     jb.WorkflowSpec,
+		Events:   events,
+		Fixtures: fixtures,
+	})
+	if err = s.Render(NewWorkflowSimulationPresenter(result)); err != nil {
+		return s.errorOut(err)
+	}
+	if simErr != nil {
+		return s.errorOut(simErr)
+	}
+	for _, e := range result.Executions {
+		if e.Status != store.StatusCompleted && e.Status != store.StatusCompletedEarlyExit {
+			return s.errorOut(errors.New("simulated executions did not all complete"))
+		}
+	}
+	return nil
+}
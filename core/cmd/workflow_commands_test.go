@@ -0,0 +1,86 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		inputs    = `{"price":100}`
+		stepErr   = "write failed"
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowExecutionPresenter{
+		JAID: cmd.NewJAID("exec1"),
+		WorkflowExecutionResource: presenters.WorkflowExecutionResource{
+			JAID:       presenters.NewJAID("exec1"),
+			WorkflowID: "wf1",
+			Status:     "errored",
+			CreatedAt:  &createdAt,
+			Steps: []presenters.WorkflowExecutionStepResource{
+				{Ref: "write", Status: "errored", Inputs: &inputs, Error: &stepErr},
+			},
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "exec1")
+	assert.Contains(t, output, "wf1")
+	assert.Contains(t, output, "errored")
+	assert.Contains(t, output, inputs)
+	assert.Contains(t, output, stepErr)
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.WorkflowExecutionPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "exec1")
+	assert.Contains(t, output, "wf1")
+	assert.NotContains(t, output, stepErr)
+}
+
+func TestWorkflowSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		outputs   = `{"report":"0xdeadbeef"}`
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowSimulationPresenter{
+		Trace: []cmd.WorkflowSimulationStep{
+			{ExecutionID: "exec1", WorkflowExecutionStepResource: presenters.WorkflowExecutionStepResource{Ref: "evm_median", Status: "completed", Attempts: 1, Outputs: &outputs}},
+		},
+		Executions: cmd.WorkflowExecutionPresenters{{
+			JAID:                      cmd.NewJAID("exec1"),
+			WorkflowExecutionResource: presenters.WorkflowExecutionResource{WorkflowID: "wf1", Status: "completed", CreatedAt: &createdAt},
+		}},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "exec1")
+	assert.Contains(t, output, "evm_median")
+	assert.Contains(t, output, outputs)
+	assert.Contains(t, output, "wf1")
+}
@@ -100,6 +100,9 @@ func TestDoc(t *testing.T) {
 		// GasEstimator.DAOracle.OracleAddress is only set if DA oracle config is used
 		docDefaults.GasEstimator.DAOracle.OracleAddress = nil
 
+		// GasEstimator.Remote.URL is only set if Remote estimator is used
+		docDefaults.GasEstimator.Remote.URL = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -9,6 +9,14 @@ import (
 type JobPipeline interface {
 	DefaultHTTPLimit() int64
 	DefaultHTTPTimeout() commonconfig.Duration
+	HTTPCacheEnabled() bool
+	HTTPCacheTTL() time.Duration
+	HTTPCacheMaxStaleOnError() time.Duration
+	HTTPHostMaxConcurrency() uint32
+	HTTPHostRPS() float64
+	HTTPHostBurst() int
+	HTTPCircuitBreakerFailureThreshold() uint32
+	HTTPCircuitBreakerOpenTimeout() time.Duration
 	MaxRunDuration() time.Duration
 	MaxSuccessfulRuns() uint64
 	ReaperInterval() time.Duration
@@ -597,18 +597,19 @@ func (l *LogFile) setFrom(f *LogFile) {
 }
 
 type WebServer struct {
-	AuthenticationMethod    *string
-	AllowOrigins            *string
-	BridgeResponseURL       *commonconfig.URL
-	BridgeCacheTTL          *commonconfig.Duration
-	HTTPWriteTimeout        *commonconfig.Duration
-	HTTPPort                *uint16
-	SecureCookies           *bool
-	SessionTimeout          *commonconfig.Duration
-	SessionReaperExpiration *commonconfig.Duration
-	HTTPMaxSize             *utils.FileSize
-	StartTimeout            *commonconfig.Duration
-	ListenIP                *net.IP
+	AuthenticationMethod      *string
+	AllowOrigins              *string
+	BridgeResponseURL         *commonconfig.URL
+	BridgeCacheTTL            *commonconfig.Duration
+	BridgeHealthCheckInterval *commonconfig.Duration
+	HTTPWriteTimeout          *commonconfig.Duration
+	HTTPPort                  *uint16
+	SecureCookies             *bool
+	SessionTimeout            *commonconfig.Duration
+	SessionReaperExpiration   *commonconfig.Duration
+	HTTPMaxSize               *utils.FileSize
+	StartTimeout              *commonconfig.Duration
+	ListenIP                  *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
@@ -629,6 +630,9 @@ func (w *WebServer) setFrom(f *WebServer) {
 	if v := f.BridgeCacheTTL; v != nil {
 		w.BridgeCacheTTL = v
 	}
+	if v := f.BridgeHealthCheckInterval; v != nil {
+		w.BridgeHealthCheckInterval = v
+	}
 	if v := f.HTTPWriteTimeout; v != nil {
 		w.HTTPWriteTimeout = v
 	}
@@ -904,6 +908,10 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 type JobPipelineHTTPRequest struct {
 	DefaultTimeout *commonconfig.Duration
 	MaxSize        *utils.FileSize
+
+	Cache          JobPipelineHTTPRequestCache          `toml:",omitempty"`
+	HostLimits     JobPipelineHTTPRequestHostLimits     `toml:",omitempty"`
+	CircuitBreaker JobPipelineHTTPRequestCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
@@ -913,6 +921,83 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	if v := f.MaxSize; v != nil {
 		j.MaxSize = v
 	}
+	j.Cache.setFrom(&f.Cache)
+	j.HostLimits.setFrom(&f.HostLimits)
+	j.CircuitBreaker.setFrom(&f.CircuitBreaker)
+}
+
+type JobPipelineHTTPRequestCache struct {
+	Enabled         *bool
+	TTL             *commonconfig.Duration
+	MaxStaleOnError *commonconfig.Duration
+}
+
+func (j *JobPipelineHTTPRequestCache) setFrom(f *JobPipelineHTTPRequestCache) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.TTL; v != nil {
+		j.TTL = v
+	}
+	if v := f.MaxStaleOnError; v != nil {
+		j.MaxStaleOnError = v
+	}
+}
+
+func (j *JobPipelineHTTPRequestCache) ValidateConfig() (err error) {
+	if j.Enabled != nil && *j.Enabled && j.TTL != nil && j.TTL.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TTL", Value: j.TTL.String(), Msg: "must be greater than zero when the cache is enabled"})
+	}
+	return
+}
+
+type JobPipelineHTTPRequestHostLimits struct {
+	MaxConcurrency *uint32
+	RPS            *float64
+	Burst          *int
+}
+
+func (j *JobPipelineHTTPRequestHostLimits) setFrom(f *JobPipelineHTTPRequestHostLimits) {
+	if v := f.MaxConcurrency; v != nil {
+		j.MaxConcurrency = v
+	}
+	if v := f.RPS; v != nil {
+		j.RPS = v
+	}
+	if v := f.Burst; v != nil {
+		j.Burst = v
+	}
+}
+
+func (j *JobPipelineHTTPRequestHostLimits) ValidateConfig() (err error) {
+	if j.RPS != nil && *j.RPS < 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "RPS", Value: *j.RPS, Msg: "must not be negative"})
+	}
+	if j.RPS != nil && *j.RPS > 0 && j.Burst != nil && *j.Burst < 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Burst", Value: *j.Burst, Msg: "must be at least 1 when RPS is set"})
+	}
+	return
+}
+
+type JobPipelineHTTPRequestCircuitBreaker struct {
+	FailureThreshold *uint32
+	OpenTimeout      *commonconfig.Duration
+}
+
+func (j *JobPipelineHTTPRequestCircuitBreaker) setFrom(f *JobPipelineHTTPRequestCircuitBreaker) {
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		j.OpenTimeout = v
+	}
+}
+
+func (j *JobPipelineHTTPRequestCircuitBreaker) ValidateConfig() (err error) {
+	if j.FailureThreshold != nil && *j.FailureThreshold > 0 && j.OpenTimeout != nil && j.OpenTimeout.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "OpenTimeout", Value: j.OpenTimeout.String(), Msg: "must be greater than zero when the circuit breaker is enabled"})
+	}
+	return
 }
 
 type FluxMonitor struct {
@@ -1534,19 +1619,35 @@ type ConnectorGateway struct {
 }
 
 type Capabilities struct {
-	Peering          P2P              `toml:",omitempty"`
-	Dispatcher       Dispatcher       `toml:",omitempty"`
-	ExternalRegistry ExternalRegistry `toml:",omitempty"`
-	GatewayConnector GatewayConnector `toml:",omitempty"`
+	Peering            P2P                `toml:",omitempty"`
+	Dispatcher         Dispatcher         `toml:",omitempty"`
+	ExternalRegistry   ExternalRegistry   `toml:",omitempty"`
+	WorkflowExecutions WorkflowExecutions `toml:",omitempty"`
+	GatewayConnector   GatewayConnector   `toml:",omitempty"`
 }
 
 func (c *Capabilities) setFrom(f *Capabilities) {
 	c.Peering.setFrom(&f.Peering)
 	c.ExternalRegistry.setFrom(&f.ExternalRegistry)
+	c.WorkflowExecutions.setFrom(&f.WorkflowExecutions)
 	c.Dispatcher.setFrom(&f.Dispatcher)
 	c.GatewayConnector.setFrom(&f.GatewayConnector)
 }
 
+type WorkflowExecutions struct {
+	ReaperInterval  *commonconfig.Duration
+	ReaperThreshold *commonconfig.Duration
+}
+
+func (w *WorkflowExecutions) setFrom(f *WorkflowExecutions) {
+	if v := f.ReaperInterval; v != nil {
+		w.ReaperInterval = v
+	}
+	if v := f.ReaperThreshold; v != nil {
+		w.ReaperThreshold = v
+	}
+}
+
 type ThresholdKeyShareSecrets struct {
 	ThresholdKeyShare *models.Secret
 }
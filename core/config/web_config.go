@@ -59,6 +59,7 @@ type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
 	BridgeCacheTTL() time.Duration
+	BridgeHealthCheckInterval() time.Duration
 	BridgeResponseURL() *url.URL
 	HTTPMaxSize() int64
 	StartTimeout() time.Duration
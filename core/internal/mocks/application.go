@@ -37,6 +37,8 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -45,6 +47,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -202,6 +206,100 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeAuthenticator provides a mock function with given fields:
+func (_m *Application) BridgeAuthenticator() *bridges.Authenticator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeAuthenticator")
+	}
+
+	var r0 *bridges.Authenticator
+	if rf, ok := ret.Get(0).(func() *bridges.Authenticator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.Authenticator)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeAuthenticator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeAuthenticator'
+type Application_BridgeAuthenticator_Call struct {
+	*mock.Call
+}
+
+// BridgeAuthenticator is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeAuthenticator() *Application_BridgeAuthenticator_Call {
+	return &Application_BridgeAuthenticator_Call{Call: _e.mock.On("BridgeAuthenticator")}
+}
+
+func (_c *Application_BridgeAuthenticator_Call) Run(run func()) *Application_BridgeAuthenticator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeAuthenticator_Call) Return(_a0 *bridges.Authenticator) *Application_BridgeAuthenticator_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeAuthenticator_Call) RunAndReturn(run func() *bridges.Authenticator) *Application_BridgeAuthenticator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BridgeHealthChecker provides a mock function with given fields:
+func (_m *Application) BridgeHealthChecker() *bridges.HealthChecker {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeHealthChecker")
+	}
+
+	var r0 *bridges.HealthChecker
+	if rf, ok := ret.Get(0).(func() *bridges.HealthChecker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.HealthChecker)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeHealthChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeHealthChecker'
+type Application_BridgeHealthChecker_Call struct {
+	*mock.Call
+}
+
+// BridgeHealthChecker is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeHealthChecker() *Application_BridgeHealthChecker_Call {
+	return &Application_BridgeHealthChecker_Call{Call: _e.mock.On("BridgeHealthChecker")}
+}
+
+func (_c *Application_BridgeHealthChecker_Call) Run(run func()) *Application_BridgeHealthChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeHealthChecker_Call) Return(_a0 *bridges.HealthChecker) *Application_BridgeHealthChecker_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeHealthChecker_Call) RunAndReturn(run func() *bridges.HealthChecker) *Application_BridgeHealthChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1676,6 +1774,100 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowEngines provides a mock function with given fields:
+func (_m *Application) WorkflowEngines() *workflows.EngineRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowEngines")
+	}
+
+	var r0 *workflows.EngineRegistry
+	if rf, ok := ret.Get(0).(func() *workflows.EngineRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflows.EngineRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowEngines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowEngines'
+type Application_WorkflowEngines_Call struct {
+	*mock.Call
+}
+
+// WorkflowEngines is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowEngines() *Application_WorkflowEngines_Call {
+	return &Application_WorkflowEngines_Call{Call: _e.mock.On("WorkflowEngines")}
+}
+
+func (_c *Application_WorkflowEngines_Call) Run(run func()) *Application_WorkflowEngines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) Return(_a0 *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowEngines_Call) RunAndReturn(run func() *workflows.EngineRegistry) *Application_WorkflowEngines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
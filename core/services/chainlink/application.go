@@ -103,6 +103,10 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeAuthenticator() *bridges.Authenticator
+	BridgeHealthChecker() *bridges.HealthChecker
+	WorkflowORM() workflowstore.Store
+	WorkflowEngines() *workflows.EngineRegistry
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
@@ -141,6 +145,10 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeAuth               *bridges.Authenticator
+	bridgeHealth             *bridges.HealthChecker
+	workflowORM              workflowstore.Store
+	workflowEngines          *workflows.EngineRegistry
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
@@ -387,7 +395,15 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
 		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
 		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		bridgeAuth     = bridges.NewAuthenticator(cfg.Password().Keystore())
+		bridgeHealth   = bridges.NewHealthChecker(bridgeORM, bridgeAuth, unrestrictedHTTPClient, globalLogger, cfg.WebServer().BridgeHealthCheckInterval())
 	)
+	pipelineRunner.SetBridgeAuthenticator(bridgeAuth)
+	pipelineRunner.SetBridgeHealthChecker(bridgeHealth)
+	srvcs = append(srvcs, bridgeHealth)
+
+	workflowReaper := workflowstore.NewReaper(workflowORM, cfg.Capabilities().WorkflowExecutions().ReaperInterval(), cfg.Capabilities().WorkflowExecutions().ReaperThreshold(), clockwork.NewRealClock(), globalLogger)
+	srvcs = append(srvcs, workflowReaper)
 
 	promReporter := headreporter.NewPrometheusReporter(opts.DS, legacyEVMChains)
 	chainIDs := make([]*big.Int, legacyEVMChains.Len())
@@ -462,10 +478,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
 
+	workflowEngines := workflows.NewEngineRegistry(workflowORM)
 	delegates[job.Workflow] = workflows.NewDelegate(
 		globalLogger,
 		opts.CapabilitiesRegistry,
 		workflowORM,
+		workflowEngines,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -637,6 +655,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeAuth:               bridgeAuth,
+		bridgeHealth:             bridgeHealth,
+		workflowORM:              workflowORM,
+		workflowEngines:          workflowEngines,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
@@ -817,6 +839,22 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) BridgeAuthenticator() *bridges.Authenticator {
+	return app.bridgeAuth
+}
+
+func (app *ChainlinkApplication) BridgeHealthChecker() *bridges.HealthChecker {
+	return app.bridgeHealth
+}
+
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
+func (app *ChainlinkApplication) WorkflowEngines() *workflows.EngineRegistry {
+	return app.workflowEngines
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
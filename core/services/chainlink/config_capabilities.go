@@ -1,6 +1,8 @@
 package chainlink
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
@@ -22,6 +24,22 @@ func (c *capabilitiesConfig) ExternalRegistry() config.CapabilitiesExternalRegis
 	}
 }
 
+func (c *capabilitiesConfig) WorkflowExecutions() config.WorkflowExecutions {
+	return &workflowExecutions{c: c.c.WorkflowExecutions}
+}
+
+type workflowExecutions struct {
+	c toml.WorkflowExecutions
+}
+
+func (w *workflowExecutions) ReaperInterval() time.Duration {
+	return w.c.ReaperInterval.Duration()
+}
+
+func (w *workflowExecutions) ReaperThreshold() time.Duration {
+	return w.c.ReaperThreshold.Duration()
+}
+
 func (c *capabilitiesConfig) Dispatcher() config.Dispatcher {
 	return &dispatcher{d: c.c.Dispatcher}
 }
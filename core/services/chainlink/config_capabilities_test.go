@@ -43,4 +43,8 @@ func TestCapabilitiesConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, v2.DeltaDial().Duration())
 	assert.Equal(t, 2*time.Second, v2.DeltaReconcile().Duration())
 	assert.Equal(t, []string{"foo", "bar"}, v2.ListenAddresses())
+
+	wfe := cfg.Capabilities().WorkflowExecutions()
+	assert.Equal(t, 4*time.Hour, wfe.ReaperInterval())
+	assert.Equal(t, 30*24*time.Hour, wfe.ReaperThreshold())
 }
@@ -22,6 +22,38 @@ func (j *jobPipelineConfig) DefaultHTTPTimeout() commonconfig.Duration {
 	return *j.c.HTTPRequest.DefaultTimeout
 }
 
+func (j *jobPipelineConfig) HTTPCacheEnabled() bool {
+	return *j.c.HTTPRequest.Cache.Enabled
+}
+
+func (j *jobPipelineConfig) HTTPCacheTTL() time.Duration {
+	return j.c.HTTPRequest.Cache.TTL.Duration()
+}
+
+func (j *jobPipelineConfig) HTTPCacheMaxStaleOnError() time.Duration {
+	return j.c.HTTPRequest.Cache.MaxStaleOnError.Duration()
+}
+
+func (j *jobPipelineConfig) HTTPHostMaxConcurrency() uint32 {
+	return *j.c.HTTPRequest.HostLimits.MaxConcurrency
+}
+
+func (j *jobPipelineConfig) HTTPHostRPS() float64 {
+	return *j.c.HTTPRequest.HostLimits.RPS
+}
+
+func (j *jobPipelineConfig) HTTPHostBurst() int {
+	return *j.c.HTTPRequest.HostLimits.Burst
+}
+
+func (j *jobPipelineConfig) HTTPCircuitBreakerFailureThreshold() uint32 {
+	return *j.c.HTTPRequest.CircuitBreaker.FailureThreshold
+}
+
+func (j *jobPipelineConfig) HTTPCircuitBreakerOpenTimeout() time.Duration {
+	return j.c.HTTPRequest.CircuitBreaker.OpenTimeout.Duration()
+}
+
 func (j *jobPipelineConfig) MaxRunDuration() time.Duration {
 	return j.c.MaxRunDuration.Duration()
 }
@@ -24,6 +24,14 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	d, err := commonconfig.NewDuration(1 * time.Minute)
 	require.NoError(t, err)
 	assert.Equal(t, d, jp.DefaultHTTPTimeout())
+	assert.True(t, jp.HTTPCacheEnabled())
+	assert.Equal(t, 30*time.Second, jp.HTTPCacheTTL())
+	assert.Equal(t, 5*time.Minute, jp.HTTPCacheMaxStaleOnError())
+	assert.Equal(t, uint32(8), jp.HTTPHostMaxConcurrency())
+	assert.Equal(t, 2.5, jp.HTTPHostRPS())
+	assert.Equal(t, 5, jp.HTTPHostBurst())
+	assert.Equal(t, uint32(3), jp.HTTPCircuitBreakerFailureThreshold())
+	assert.Equal(t, 2*time.Minute, jp.HTTPCircuitBreakerOpenTimeout())
 	assert.Equal(t, 1*time.Hour, jp.MaxRunDuration())
 	assert.Equal(t, uint64(123456), jp.MaxSuccessfulRuns())
 	assert.Equal(t, 4*time.Hour, jp.ReaperInterval())
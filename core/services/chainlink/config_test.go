@@ -359,18 +359,19 @@ func TestConfig_Marshal(t *testing.T) {
 		},
 	}
 	full.WebServer = toml.WebServer{
-		AuthenticationMethod:    ptr("local"),
-		AllowOrigins:            ptr("*"),
-		BridgeResponseURL:       mustURL("https://bridge.response"),
-		BridgeCacheTTL:          commoncfg.MustNewDuration(10 * time.Second),
-		HTTPWriteTimeout:        commoncfg.MustNewDuration(time.Minute),
-		HTTPPort:                ptr[uint16](56),
-		SecureCookies:           ptr(true),
-		SessionTimeout:          commoncfg.MustNewDuration(time.Hour),
-		SessionReaperExpiration: commoncfg.MustNewDuration(7 * 24 * time.Hour),
-		HTTPMaxSize:             ptr(utils.FileSize(uint64(32770))),
-		StartTimeout:            commoncfg.MustNewDuration(15 * time.Second),
-		ListenIP:                mustIP("192.158.1.37"),
+		AuthenticationMethod:      ptr("local"),
+		AllowOrigins:              ptr("*"),
+		BridgeResponseURL:         mustURL("https://bridge.response"),
+		BridgeCacheTTL:            commoncfg.MustNewDuration(10 * time.Second),
+		BridgeHealthCheckInterval: commoncfg.MustNewDuration(time.Minute),
+		HTTPWriteTimeout:          commoncfg.MustNewDuration(time.Minute),
+		HTTPPort:                  ptr[uint16](56),
+		SecureCookies:             ptr(true),
+		SessionTimeout:            commoncfg.MustNewDuration(time.Hour),
+		SessionReaperExpiration:   commoncfg.MustNewDuration(7 * 24 * time.Hour),
+		HTTPMaxSize:               ptr(utils.FileSize(uint64(32770))),
+		StartTimeout:              commoncfg.MustNewDuration(15 * time.Second),
+		ListenIP:                  mustIP("192.158.1.37"),
 		MFA: toml.WebServerMFA{
 			RPID:     ptr("test-rpid"),
 			RPOrigin: ptr("test-rp-origin"),
@@ -420,6 +421,20 @@ func TestConfig_Marshal(t *testing.T) {
 		HTTPRequest: toml.JobPipelineHTTPRequest{
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
+			Cache: toml.JobPipelineHTTPRequestCache{
+				Enabled:         ptr(true),
+				TTL:             commoncfg.MustNewDuration(30 * time.Second),
+				MaxStaleOnError: commoncfg.MustNewDuration(5 * time.Minute),
+			},
+			HostLimits: toml.JobPipelineHTTPRequestHostLimits{
+				MaxConcurrency: ptr[uint32](8),
+				RPS:            ptr(2.5),
+				Burst:          ptr(5),
+			},
+			CircuitBreaker: toml.JobPipelineHTTPRequestCircuitBreaker{
+				FailureThreshold: ptr[uint32](3),
+				OpenTimeout:      commoncfg.MustNewDuration(2 * time.Minute),
+			},
 		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
@@ -494,6 +509,10 @@ func TestConfig_Marshal(t *testing.T) {
 			ChainID:   ptr("1"),
 			NetworkID: ptr("evm"),
 		},
+		WorkflowExecutions: toml.WorkflowExecutions{
+			ReaperInterval:  commoncfg.MustNewDuration(4 * time.Hour),
+			ReaperThreshold: commoncfg.MustNewDuration(30 * 24 * time.Hour),
+		},
 		Dispatcher: toml.Dispatcher{
 			SupportedVersion:   ptr(1),
 			ReceiverBufferSize: ptr(10000),
@@ -619,6 +638,12 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Remote: evmcfg.RemoteEstimator{
+						URL:          commoncfg.MustParseURL("http://gas.oracle.com"),
+						PollPeriod:   commoncfg.MustNewDuration(5 * time.Second),
+						Timeout:      &second,
+						FallbackMode: ptr("FixedPrice"),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -649,6 +674,7 @@ func TestConfig_Marshal(t *testing.T) {
 				Transactions: evmcfg.Transactions{
 					MaxInFlight:          ptr[uint32](19),
 					MaxQueued:            ptr[uint32](99),
+					PriorityMaxWait:      &minute,
 					ReaperInterval:       &minute,
 					ReaperThreshold:      &minute,
 					ResendAfterThreshold: &hour,
@@ -656,6 +682,11 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					NonceGapRepair: evmcfg.NonceGapRepairConfig{
+						Enabled:  ptr(true),
+						Interval: commoncfg.MustNewDuration(5 * time.Minute),
+						Mode:     ptr(evmcfg.NonceGapRepairModeResequence),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -678,6 +709,9 @@ func TestConfig_Marshal(t *testing.T) {
 					EnforceRepeatableRead:      ptr(true),
 					DeathDeclarationDelay:      &minute,
 					NewHeadsPollInterval:       &zeroSeconds,
+					HedgedReadsEnabled:         ptr(true),
+					HedgedReadsPercentile:      ptr[uint16](90),
+					HedgedReadsMinDelay:        &second,
 					Errors: evmcfg.ClientErrors{
 						NonceTooLow:                       ptr[string]("(: |^)nonce too low"),
 						NonceTooHigh:                      ptr[string]("(: |^)nonce too high"),
@@ -936,6 +970,7 @@ AuthenticationMethod = 'local'
 AllowOrigins = '*'
 BridgeResponseURL = 'https://bridge.response'
 BridgeCacheTTL = '10s'
+BridgeHealthCheckInterval = '1m0s'
 HTTPWriteTimeout = '1m0s'
 HTTPPort = 56
 SecureCookies = true
@@ -998,6 +1033,20 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.HTTPRequest.Cache]
+Enabled = true
+TTL = '30s'
+MaxStaleOnError = '5m0s'
+
+[JobPipeline.HTTPRequest.HostLimits]
+MaxConcurrency = 8
+RPS = 2.5
+Burst = 5
+
+[JobPipeline.HTTPRequest.CircuitBreaker]
+FailureThreshold = 3
+OpenTimeout = '2m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1111,6 +1160,7 @@ NoNewFinalizedHeadsThreshold = '1h0m0s'
 ForwardersEnabled = true
 MaxInFlight = 19
 MaxQueued = 99
+PriorityMaxWait = '1m0s'
 ReaperInterval = '1m0s'
 ReaperThreshold = '1m0s'
 ResendAfterThreshold = '1h0m0s'
@@ -1118,6 +1168,11 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.NonceGapRepair]
+Enabled = true
+Interval = '5m0s'
+Mode = 'Resequence'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
@@ -1159,6 +1214,12 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Remote]
+URL = 'http://gas.oracle.com'
+PollPeriod = '5s'
+Timeout = '1s'
+FallbackMode = 'FixedPrice'
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1184,6 +1245,9 @@ FinalizedBlockPollInterval = '1s'
 EnforceRepeatableRead = true
 DeathDeclarationDelay = '1m0s'
 NewHeadsPollInterval = '0s'
+HedgedReadsEnabled = true
+HedgedReadsPercentile = 90
+HedgedReadsMinDelay = '1s'
 
 [EVM.NodePool.Errors]
 NonceTooLow = '(: |^)nonce too low'
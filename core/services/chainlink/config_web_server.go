@@ -137,6 +137,10 @@ func (w *webServerConfig) BridgeCacheTTL() time.Duration {
 	return w.c.BridgeCacheTTL.Duration()
 }
 
+func (w *webServerConfig) BridgeHealthCheckInterval() time.Duration {
+	return w.c.BridgeHealthCheckInterval.Duration()
+}
+
 func (w *webServerConfig) HTTPMaxSize() int64 {
 	return int64(*w.c.HTTPMaxSize)
 }
@@ -190,6 +190,7 @@ type Job struct {
 	SchemaVersion                 uint32        `toml:"schemaVersion"`
 	GasLimit                      clnull.Uint32 `toml:"gasLimit"`
 	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
+	CaptureResponses              bool          `toml:"captureResponses"`
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               models.Interval
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
@@ -880,8 +881,11 @@ type WorkflowSpec struct {
 	CreatedAt     time.Time        `toml:"-"`
 	UpdatedAt     time.Time        `toml:"-"`
 	SpecType      WorkflowSpecType `toml:"spec_type" db:"spec_type"`
-	sdkWorkflow   *sdk.WorkflowSpec
-	rawSpec       []byte
+	// MaxExecutionDuration is the deadline of each execution of the workflow. If zero, the engine default applies.
+	MaxExecutionDuration models.Interval `toml:"maxExecutionDuration" db:"max_execution_duration"`
+
+	sdkWorkflow *sdk.WorkflowSpec
+	rawSpec     []byte
 }
 
 var (
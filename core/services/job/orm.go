@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -52,6 +53,8 @@ type ORM interface {
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
+	// FindJobIDsWithFragment returns the IDs of the jobs whose pipeline invokes the named fragment.
+	FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
@@ -163,6 +166,42 @@ func (o *orm) AssertBridgesExist(ctx context.Context, p pipeline.Pipeline) error
 	return nil
 }
 
+// assertFragmentsExist checks that every fragment invoked by p exists, in the requested version if pinned.
+func (o *orm) assertFragmentsExist(ctx context.Context, p pipeline.Pipeline) error {
+	for _, task := range p.Tasks {
+		name, rawVersion, ok := fragmentOf(task)
+		if !ok {
+			continue
+		}
+		// versions given as variables are only known at run time
+		var version uint64
+		if rawVersion != "" {
+			var err error
+			if version, err = strconv.ParseUint(rawVersion, 10, 31); err != nil {
+				continue
+			}
+		}
+		if _, err := o.pipelineORM.FindFragment(ctx, name, int32(version)); errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("fragment %q version %d does not exist", name, version)
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to find fragment %q", name)
+		}
+	}
+	return nil
+}
+
+// fragmentOf returns the name and version of the fragment run by task, if it runs one.
+func fragmentOf(task pipeline.Task) (name string, version string, ok bool) {
+	switch t := task.(type) {
+	case *pipeline.FragmentTask:
+		return t.Name, t.Version, true
+	case *pipeline.MapTask:
+		return t.Fragment, t.Version, t.Fragment != ""
+	default:
+		return "", "", false
+	}
+}
+
 // CreateJob creates the job, and it's associated spec record.
 // Expects an unmarshalled job spec as the jb argument i.e. output from ValidatedXX.
 // Scans all persisted records back into jb
@@ -171,6 +210,9 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 	if err := o.AssertBridgesExist(ctx, p); err != nil {
 		return err
 	}
+	if err := o.assertFragmentsExist(ctx, p); err != nil {
+		return err
+	}
 
 	var jobID int32
 	err := o.transact(ctx, false, func(tx *orm) error {
@@ -408,8 +450,8 @@ func (o *orm) CreateJob(ctx context.Context, jb *Job) error {
 		case Stream:
 			// 'stream' type has no associated spec, nothing to do here
 		case Workflow:
-			sql := `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at, spec_type, config)
-			VALUES (:workflow, :workflow_id, :workflow_owner, :workflow_name, NOW(), NOW(), :spec_type, :config)
+			sql := `INSERT INTO workflow_specs (workflow, workflow_id, workflow_owner, workflow_name, created_at, updated_at, spec_type, config, max_execution_duration)
+			VALUES (:workflow, :workflow_id, :workflow_owner, :workflow_name, NOW(), NOW(), :spec_type, :config, :max_execution_duration)
 			RETURNING id;`
 			specID, err := tx.prepareQuerySpecID(ctx, sql, jb.WorkflowSpec)
 			if err != nil {
@@ -671,18 +713,18 @@ func (o *orm) InsertJob(ctx context.Context, job *Job) error {
 		if job.ID == 0 {
 			query = `INSERT INTO jobs (name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, capture_responses, created_at)
 		VALUES (:name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :capture_responses, NOW())
 		RETURNING *;`
 		} else {
 			query = `INSERT INTO jobs (id, name, stream_id, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 			keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, block_header_feeder_spec_id, gateway_spec_id,
-                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, created_at)
+                  legacy_gas_station_server_spec_id, legacy_gas_station_sidecar_spec_id, workflow_spec_id, standard_capabilities_spec_id, ccip_spec_id, external_job_id, gas_limit, forwarding_allowed, capture_responses, created_at)
 		VALUES (:id, :name, :stream_id, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :block_header_feeder_spec_id, :gateway_spec_id,
-				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, NOW())
+				:legacy_gas_station_server_spec_id, :legacy_gas_station_sidecar_spec_id, :workflow_spec_id, :standard_capabilities_spec_id, :ccip_spec_id, :external_job_id, :gas_limit, :forwarding_allowed, :capture_responses, NOW())
 		RETURNING *;`
 		}
 		query, args, err := tx.ds.BindNamed(query, job)
@@ -1102,6 +1144,42 @@ func (o *orm) FindJobIDsWithBridge(ctx context.Context, name string) (jids []int
 	return
 }
 
+func (o *orm) FindJobIDsWithFragment(ctx context.Context, name string) (jids []int32, err error) {
+	query := `SELECT
+			jobs.id, pipeline_specs.dot_dag_source
+		FROM jobs
+		    JOIN job_pipeline_specs ON job_pipeline_specs.job_id = jobs.id
+		    JOIN pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id
+		WHERE pipeline_specs.dot_dag_source ILIKE '%' || $1 || '%' ORDER BY id`
+	type candidate struct {
+		ID           int32
+		DotDagSource string
+	}
+	var candidates []candidate
+	if err = o.ds.SelectContext(ctx, &candidates, query, name); err != nil {
+		return nil, errors.Wrap(err, "failed to find jobs with fragment")
+	}
+
+	for _, c := range candidates {
+		var p *pipeline.Pipeline
+		p, err = pipeline.Parse(c.DotDagSource)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse dag for job %d", c.ID)
+		}
+		for _, task := range p.Tasks {
+			// a job may have several pipeline specs, which are ordered by job ID
+			if fragment, _, ok := fragmentOf(task); ok && fragment == name {
+				if len(jids) == 0 || jids[len(jids)-1] != c.ID {
+					jids = append(jids, c.ID)
+				}
+				break
+			}
+		}
+	}
+
+	return
+}
+
 func (o *orm) FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (jobID int32, err error) {
 	stmt := `
 SELECT jobs.id FROM jobs
@@ -0,0 +1,44 @@
+package job
+
+import (
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// ErrNoPipelineToSimulate is returned for job specs without an observationSource
+var ErrNoPipelineToSimulate = errors.New("job spec has no observationSource to simulate")
+
+// SimulationSpec validates the job spec ts, and returns the pipeline spec to
+// run with pipeline.Simulate. Only the common job spec fields are validated,
+// as the type specific fields (contract addresses, keys, etc.) are not used by
+// the pipeline itself.
+func SimulationSpec(ts string) (pipeline.Spec, error) {
+	jobType, err := ValidateSpec(ts)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	var jb Job
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	if err = tree.Unmarshal(&jb); err != nil {
+		return pipeline.Spec{}, err
+	}
+	if jb.Pipeline.Source == "" {
+		return pipeline.Spec{}, ErrNoPipelineToSimulate
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jobType),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return spec, nil
+}
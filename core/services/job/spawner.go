@@ -203,6 +203,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	jb.PipelineSpec.JobID = jb.ID
 	jb.PipelineSpec.JobType = string(jb.Type)
 	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
+	jb.PipelineSpec.CaptureResponses = jb.CaptureResponses
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
@@ -125,6 +125,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         types.TxPriorityCritical,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
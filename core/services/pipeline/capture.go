@@ -0,0 +1,102 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// capturedTaskTypes are the tasks whose results are captured for jobs with
+// captureResponses enabled. Every other task is re-executed on replay.
+// It includes every task which must have a fixture to be simulated, so that
+// replaying never reads from chains or sends transactions again. Fragment and
+// map tasks are captured as a whole, since they run nested pipelines, which
+// may be loaded from the database.
+var capturedTaskTypes = map[TaskType]struct{}{
+	TaskTypeHTTP:             {},
+	TaskTypeBridge:           {},
+	TaskTypeETHCall:          {},
+	TaskTypeETHTx:            {},
+	TaskTypeEstimateGasLimit: {},
+	TaskTypeVRF:              {},
+	TaskTypeVRFV2:            {},
+	TaskTypeVRFV2Plus:        {},
+	TaskTypeFragment:         {},
+	TaskTypeMap:              {},
+}
+
+// newRunCapture starts capturing a run with the variables it was started
+// with. Tasks add their results to vars as they finish, so they are copied.
+func newRunCapture(vars Vars) *SimulationFixtures {
+	initial := make(map[string]interface{}, len(vars.vars))
+	for k, v := range vars.vars {
+		initial[k] = encodeCapturedValue(v)
+	}
+	return &SimulationFixtures{Vars: initial, Tasks: make(map[string]SimulationFixture)}
+}
+
+// encodeCapturedValue copies v, with bytes hex-encoded at any depth, since
+// replayed tasks decode hex strings back to bytes, whereas JSON would encode
+// them as base64 strings.
+func encodeCapturedValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = encodeCapturedValue(e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = encodeCapturedValue(e)
+		}
+		return s
+	case [][]byte:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = hexutil.Encode(e)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// record adds the raw responses of the captured tasks in results, in the
+// same form as SimulationFixture, so that the run can be replayed by Simulate.
+func (f *SimulationFixtures) record(results map[int]TaskRunResult) {
+	for _, result := range results {
+		if _, captured := capturedTaskTypes[result.Task.Type()]; !captured {
+			continue
+		}
+		if result.Result.Error != nil {
+			f.Tasks[result.Task.DotID()] = SimulationFixture{Error: result.Result.Error.Error()}
+			continue
+		}
+		f.Tasks[result.Task.DotID()] = SimulationFixture{Value: encodeCapturedValue(result.Result.Value)}
+	}
+}
+
+// Replay re-executes the stored run with the given ID in-memory, with the
+// results of its http, bridge, chain and transaction tasks taken from those
+// captured when it originally ran. It returns the stored run and the replay.
+// Tasks which are neither captured nor deterministic, such as `any`, may
+// produce different results.
+func Replay(ctx context.Context, cfg Config, lggr logger.Logger, orm ORM, runID int64) (original Run, replay *Run, err error) {
+	original, err = orm.FindRun(ctx, runID)
+	if err != nil {
+		return original, nil, errors.Wrapf(err, "failed to load run %d", runID)
+	}
+	capture, err := orm.FindRunCapture(ctx, runID)
+	if err != nil {
+		return original, nil, errors.Wrapf(err, "failed to load capture of run %d", runID)
+	}
+	replay, err = Simulate(ctx, cfg, lggr.Named("Replay"), original.PipelineSpec, capture)
+	return original, replay, err
+}
@@ -0,0 +1,170 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestRunner_CaptureResponses(t *testing.T) {
+	t.Parallel()
+
+	var price atomic.Int64
+	price.Store(100)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"price": price.Load()})
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	c := &http.Client{}
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, lggr, c, c)
+
+	spec := pipeline.Spec{
+		DotDagSource: `
+			ds          [type=http method=GET url="$(url)" allowUnrestrictedNetworkAccess=true];
+			ds_parse    [type=jsonparse path="price"];
+			ds_multiply [type=multiply times="$(times)"];
+			ds -> ds_parse -> ds_multiply;
+		`,
+	}
+	vars := func() pipeline.Vars {
+		return pipeline.NewVarsFrom(map[string]interface{}{"url": s.URL, "times": 10})
+	}
+
+	t.Run("does not capture by default", func(t *testing.T) {
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, vars())
+		require.NoError(t, err)
+		assert.Nil(t, run.Capture)
+	})
+
+	t.Run("captures responses and replays them", func(t *testing.T) {
+		spec := spec
+		spec.CaptureResponses = true
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, vars())
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.NotNil(t, run.Capture)
+
+		assert.Equal(t, s.URL, run.Capture.Vars["url"])
+		assert.NotContains(t, run.Capture.Vars, "ds", "captured vars must be the initial vars")
+		require.Len(t, run.Capture.Tasks, 1, "tasks which are re-executed on replay are not captured")
+		assert.JSONEq(t, `{"price":100}`, run.Capture.Tasks["ds"].Value.(string))
+
+		// the capture is stored as JSON
+		b, err := json.Marshal(run.Capture)
+		require.NoError(t, err)
+		capture, err := pipeline.ParseSimulationFixtures(b)
+		require.NoError(t, err)
+
+		// the source has changed since, but the replay uses the captured response
+		price.Store(200)
+		replay, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), lggr, spec, capture)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusCompleted, replay.State)
+
+		original, err := run.StringOutputs()
+		require.NoError(t, err)
+		replayed, err := replay.StringOutputs()
+		require.NoError(t, err)
+		require.Len(t, replayed, 1)
+		assert.Equal(t, "1000", *replayed[0])
+		assert.Equal(t, *original[0], *replayed[0])
+	})
+
+	t.Run("captures byte vars hex-encoded", func(t *testing.T) {
+		spec := pipeline.Spec{
+			DotDagSource:     `parse [type=jsonparse data="$(jobRun.logData)" path="price"];`,
+			CaptureResponses: true,
+		}
+		logData := []byte(`{"price":7}`)
+		run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"logData": logData, "logTopics": []interface{}{[]byte{0x01}}},
+		}))
+		require.NoError(t, err)
+		require.NotNil(t, run.Capture)
+		assert.Equal(t, map[string]interface{}{
+			"logData":   hexutil.Encode(logData),
+			"logTopics": []interface{}{"0x01"},
+		}, run.Capture.Vars["jobRun"])
+
+		b, err := json.Marshal(run.Capture)
+		require.NoError(t, err)
+		capture, err := pipeline.ParseSimulationFixtures(b)
+		require.NoError(t, err)
+		replay, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), lggr, spec, capture)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusCompleted, replay.State)
+		replayed, err := replay.StringOutputs()
+		require.NoError(t, err)
+		require.Len(t, replayed, 1)
+		assert.Equal(t, "7", *replayed[0])
+	})
+}
+
+func TestRunner_CaptureResponses_ETHTx(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	keyStore := keystoremocks.NewEth(t)
+	txManager := txmmocks.NewMockEvmTxManager(t)
+	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, keyStore, nil, lggr, nil, nil)
+
+	keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil).Once()
+	// the transaction must only be sent by the original run
+	txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(tx txmgr.TxRequest) bool {
+		return tx.FromAddress == from && tx.ToAddress == to
+	})).Return(txmgr.Tx{}, nil).Once()
+
+	spec := pipeline.Spec{
+		DotDagSource: `
+			encode [type=ethabiencode abi="setValue(uint256 value)" data=<{"value": $(value)}>];
+			submit [type=ethtx from=<["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]> to="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="$(encode)" gasLimit=12345 minConfirmations=0 evmChainID="0"];
+			encode -> submit;
+		`,
+		CaptureResponses: true,
+	}
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(map[string]interface{}{"value": 42}))
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, run.State)
+	require.False(t, run.HasErrors())
+	require.NotNil(t, run.Capture)
+	require.Contains(t, run.Capture.Tasks, "submit")
+
+	b, err := json.Marshal(run.Capture)
+	require.NoError(t, err)
+	capture, err := pipeline.ParseSimulationFixtures(b)
+	require.NoError(t, err)
+
+	replay, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), lggr, spec, capture)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, replay.State)
+	submit := replay.ByDotID("submit")
+	require.NotNil(t, submit)
+	assert.False(t, submit.Error.Valid, submit.Error.String)
+}
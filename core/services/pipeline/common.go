@@ -67,6 +67,14 @@ type (
 	Config interface {
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() commonconfig.Duration
+		HTTPCacheEnabled() bool
+		HTTPCacheTTL() time.Duration
+		HTTPCacheMaxStaleOnError() time.Duration
+		HTTPHostMaxConcurrency() uint32
+		HTTPHostRPS() float64
+		HTTPHostBurst() int
+		HTTPCircuitBreakerFailureThreshold() uint32
+		HTTPCircuitBreakerOpenTimeout() time.Duration
 		MaxRunDuration() time.Duration
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
@@ -108,6 +116,11 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+
+	// elements are the results of the individual elements of map tasks
+	elements []TaskRunResult
+	// bridgeName is the resolved name of the bridge a pending bridge task sent its request to
+	bridgeName string
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -295,6 +308,7 @@ func (t TaskType) String() string {
 }
 
 const (
+	TaskTypeAggregate        TaskType = "aggregate"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
@@ -306,9 +320,11 @@ const (
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
+	TaskTypeETHABIEncodeJSON TaskType = "ethabiencodejson"
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeFragment         TaskType = "fragment"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
@@ -317,6 +333,7 @@ const (
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
 	TaskTypeLowercase        TaskType = "lowercase"
+	TaskTypeMap              TaskType = "map"
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMerge            TaskType = "merge"
@@ -365,6 +382,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMedian:
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAggregate:
+		task = &AggregateTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
@@ -395,6 +414,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &ETHABIEncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode2:
 		task = &ETHABIEncodeTask2{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHABIEncodeJSON:
+		task = &ETHABIEncodeJSONTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeFragment:
+		task = &FragmentTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeMap:
+		task = &MapTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIDecode:
 		task = &ETHABIDecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIDecodeLog:
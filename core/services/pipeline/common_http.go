@@ -22,6 +22,7 @@ func makeHTTPRequest(
 	reqHeaders []string,
 	requestData MapParam,
 	client *http.Client,
+	governor *HTTPGovernor,
 	httpLimit int64,
 ) ([]byte, int, http.Header, time.Duration, error) {
 	var bodyReader io.Reader
@@ -52,8 +53,14 @@ func makeHTTPRequest(
 		Logger:  lggr.Named("HTTPRequest"),
 	}
 
+	done, err := governor.acquire(ctx, request.URL.Host)
+	if err != nil {
+		return nil, 0, nil, 0, err
+	}
+
 	start := time.Now()
 	responseBytes, statusCode, respHeaders, err := httpRequest.SendRequest()
+	done(statusCode, err)
 	if ctx.Err() != nil {
 		return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
 	}
@@ -0,0 +1,161 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "Number of http task responses served from the node-wide HTTP cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheStaleHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_stale_hits_total",
+		Help: "Number of expired http task responses served from the node-wide HTTP cache because the request failed",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses_total",
+		Help: "Number of http task responses which were not found in the node-wide HTTP cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+// httpCacheFetchTimeout bounds shared fetches started by requests without deadline.
+const httpCacheFetchTimeout = time.Minute
+
+// httpCacheMaxEntries bounds the memory used by the cache. Once reached,
+// expired entries are pruned and new responses are not cached until there is room again.
+const httpCacheMaxEntries = 10_000
+
+// HTTPCache is a node-wide cache of successful `http` task responses, shared
+// by all jobs of the Runner. Identical requests which miss the cache at the
+// same time are coalesced into a single request.
+type HTTPCache struct {
+	mu      sync.Mutex
+	entries map[string]httpCacheEntry
+	group   singleflight.Group
+	now     func() time.Time
+}
+
+type httpCacheEntry struct {
+	response  []byte
+	fetchedAt time.Time
+	// expiresAt is the time after which the entry may not even be served stale
+	expiresAt time.Time
+}
+
+// httpCacheResult is the result of a (possibly shared) request
+type httpCacheResult struct {
+	response   []byte
+	statusCode int
+	elapsed    time.Duration
+}
+
+// NewHTTPCache returns an empty HTTPCache. Whether it is used is controlled by JobPipeline.HTTPRequest.Cache.
+func NewHTTPCache() *HTTPCache {
+	return &HTTPCache{
+		entries: make(map[string]httpCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// httpCacheKey identifies identical requests. Whether the request was made
+// with unrestricted network access is part of the key, so that a response
+// fetched from a local resource is never served to a restricted task.
+func httpCacheKey(method StringParam, url URLParam, reqHeaders []string, requestData MapParam, allowUnrestrictedNetworkAccess BoolParam) (string, error) {
+	b, err := json.Marshal([]interface{}{method, url.String(), reqHeaders, requestData, allowUnrestrictedNetworkAccess})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode http cache key")
+	}
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// getOrFetch returns the cached response for key if it is younger than ttl.
+// Otherwise it calls fetch, unless an identical fetch is already in flight in
+// which case its result is shared. If fetching fails and the cached response
+// is no older than ttl+maxStale, the stale response is returned instead of the error.
+//
+// The shared fetch does not inherit the cancellation of ctx, so that the caller
+// which started it can give up without failing the other callers waiting for it.
+// It is bounded by the deadline of ctx instead, or by httpCacheFetchTimeout.
+func (c *HTTPCache) getOrFetch(ctx context.Context, dotID string, key string, ttl, maxStale time.Duration, fetch func(ctx context.Context) (httpCacheResult, error)) (httpCacheResult, error) {
+	entry, found := c.get(key)
+	if found && c.now().Sub(entry.fetchedAt) < ttl {
+		promHTTPCacheHits.WithLabelValues(dotID).Inc()
+		return httpCacheResult{response: entry.response}, nil
+	}
+	promHTTPCacheMisses.WithLabelValues(dotID).Inc()
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		fetchCtx, cancel := detachedFetchCtx(ctx)
+		defer cancel()
+		res, err := fetch(fetchCtx)
+		if err == nil {
+			c.set(key, res.response, ttl+maxStale)
+		}
+		return res, err
+	})
+
+	var res httpCacheResult
+	var err error
+	select {
+	case r := <-ch:
+		res, err = r.Val.(httpCacheResult), r.Err
+	case <-ctx.Done():
+		err = errors.Wrap(ctx.Err(), "http request timed out or interrupted")
+	}
+	if err != nil && found && c.now().Before(entry.fetchedAt.Add(ttl+maxStale)) {
+		promHTTPCacheStaleHits.WithLabelValues(dotID).Inc()
+		return httpCacheResult{response: entry.response}, nil
+	}
+	return res, err
+}
+
+// detachedFetchCtx returns a context which is not cancelled with ctx, but has the same deadline.
+func detachedFetchCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	fetchCtx := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(fetchCtx, deadline)
+	}
+	return context.WithTimeout(fetchCtx, httpCacheFetchTimeout)
+}
+
+func (c *HTTPCache) get(key string) (httpCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *HTTPCache) set(key string, response []byte, retention time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= httpCacheMaxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= httpCacheMaxEntries {
+			return
+		}
+	}
+	c.entries[key] = httpCacheEntry{response: response, fetchedAt: now, expiresAt: now.Add(retention)}
+}
@@ -0,0 +1,298 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
+)
+
+// NOTE: These metrics generate a new label per host, which is deleted once the
+// host is evicted from the HTTPGovernor after being idle for httpGovernorHostIdleTimeout.
+var (
+	promHTTPCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_http_circuit_breaker_state",
+		Help: "Circuit breaker state of outbound http and bridge task requests scoped by host (0 = closed, 1 = half-open, 2 = open)",
+	},
+		[]string{"host"},
+	)
+	promHTTPGovernorRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_http_governor_rejections_total",
+		Help: "Number of outbound http and bridge task requests which were not sent because of per-host limits, scoped by host and reason",
+	},
+		[]string{"host", "reason"},
+	)
+)
+
+// httpGovernorHostIdleTimeout is how long a host may go without requests
+// before its limits and circuit breaker are evicted from the HTTPGovernor, to
+// bound the memory and metrics used for hosts from dynamic URLs.
+const httpGovernorHostIdleTimeout = 10 * time.Minute
+
+// ErrCircuitOpen is returned for requests to a host whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+func (s circuitState) String() string {
+	switch s {
+	case circuitClosed:
+		return "closed"
+	case circuitHalfOpen:
+		return "half-open"
+	case circuitOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("circuitState(%d)", int(s))
+	}
+}
+
+// HTTPGovernor enforces per-host limits on the outbound requests of `http`
+// and `bridge` tasks: a maximum number of concurrent requests, a maximum
+// request rate, and a circuit breaker which fails requests fast while the
+// host keeps failing. It is shared by all jobs of the Runner.
+type HTTPGovernor struct {
+	maxConcurrency   uint32
+	rps              float64
+	burst            int
+	failureThreshold uint32
+	openTimeout      time.Duration
+	now              func() time.Time
+
+	mu       sync.Mutex
+	hosts    map[string]*hostGovernor
+	prunedAt time.Time
+}
+
+type hostGovernor struct {
+	slots   chan struct{}
+	limiter *rate.Limiter
+
+	// usage, guarded by HTTPGovernor.mu
+	inFlight int
+	usedAt   time.Time
+
+	// circuit breaker state, guarded by HTTPGovernor.mu
+	state    circuitState
+	failures uint32
+	openedAt time.Time
+	trial    bool
+}
+
+// NewHTTPGovernor returns an HTTPGovernor configured by JobPipeline.HTTPRequest.HostLimits and JobPipeline.HTTPRequest.CircuitBreaker.
+func NewHTTPGovernor(cfg Config) *HTTPGovernor {
+	return &HTTPGovernor{
+		maxConcurrency:   cfg.HTTPHostMaxConcurrency(),
+		rps:              cfg.HTTPHostRPS(),
+		burst:            cfg.HTTPHostBurst(),
+		failureThreshold: cfg.HTTPCircuitBreakerFailureThreshold(),
+		openTimeout:      cfg.HTTPCircuitBreakerOpenTimeout(),
+		now:              time.Now,
+		hosts:            make(map[string]*hostGovernor),
+		prunedAt:         time.Now(),
+	}
+}
+
+func (g *HTTPGovernor) enabled() bool {
+	return g != nil && (g.maxConcurrency > 0 || g.rps > 0 || g.failureThreshold > 0)
+}
+
+func (g *HTTPGovernor) host(host string) *hostGovernor {
+	h, ok := g.hosts[host]
+	if !ok {
+		h = &hostGovernor{}
+		if g.maxConcurrency > 0 {
+			h.slots = make(chan struct{}, g.maxConcurrency)
+		}
+		if g.rps > 0 {
+			h.limiter = rate.NewLimiter(rate.Limit(g.rps), g.burst)
+		}
+		g.hosts[host] = h
+	}
+	return h
+}
+
+// prune evicts the hosts which have been idle for httpGovernorHostIdleTimeout,
+// unless their circuit breaker is still open. Must be called with g.mu held.
+func (g *HTTPGovernor) prune(now time.Time) {
+	if now.Sub(g.prunedAt) < httpGovernorHostIdleTimeout {
+		return
+	}
+	g.prunedAt = now
+	for host, h := range g.hosts {
+		if h.inFlight > 0 || now.Sub(h.usedAt) < httpGovernorHostIdleTimeout {
+			continue
+		}
+		if h.state == circuitOpen && now.Sub(h.openedAt) < g.openTimeout {
+			continue
+		}
+		delete(g.hosts, host)
+		promHTTPCircuitBreakerState.DeleteLabelValues(host)
+		promHTTPGovernorRejections.DeletePartialMatch(prometheus.Labels{"host": host})
+	}
+}
+
+// release marks the end of a request to h, which may then be evicted once idle.
+func (g *HTTPGovernor) release(h *hostGovernor) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	h.inFlight--
+	h.usedAt = g.now()
+}
+
+// acquire waits until a request to host is allowed by the limits, and returns
+// a func which must be called with the outcome of the request.
+// It is safe to call on a nil HTTPGovernor.
+func (g *HTTPGovernor) acquire(ctx context.Context, host string) (func(statusCode int, err error), error) {
+	if !g.enabled() {
+		return func(int, error) {}, nil
+	}
+
+	g.mu.Lock()
+	now := g.now()
+	g.prune(now)
+	h := g.host(host)
+	h.inFlight++
+	h.usedAt = now
+	trial, err := g.allow(host, h)
+	g.mu.Unlock()
+	if err != nil {
+		g.release(h)
+		promHTTPGovernorRejections.WithLabelValues(host, "circuit_open").Inc()
+		return nil, err
+	}
+
+	if h.limiter != nil {
+		if err = h.limiter.Wait(ctx); err != nil {
+			g.abortTrial(h, trial)
+			g.release(h)
+			promHTTPGovernorRejections.WithLabelValues(host, "rate_limit").Inc()
+			return nil, errors.Wrapf(err, "rate limit of %v requests per second to %s exceeded", g.rps, host)
+		}
+	}
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+		case <-ctx.Done():
+			g.abortTrial(h, trial)
+			g.release(h)
+			promHTTPGovernorRejections.WithLabelValues(host, "concurrency").Inc()
+			return nil, errors.Wrapf(ctx.Err(), "limit of %d concurrent requests to %s exceeded", g.maxConcurrency, host)
+		}
+	}
+
+	return func(statusCode int, err error) {
+		if h.slots != nil {
+			<-h.slots
+		}
+		if ctx.Err() != nil {
+			// requests interrupted by the caller say nothing about the host
+			g.abortTrial(h, trial)
+		} else {
+			g.record(host, h, trial, isHostFailure(statusCode, err))
+		}
+		g.release(h)
+	}, nil
+}
+
+// allow applies the circuit breaker, and returns whether the request is the
+// trial request of a half-open breaker. Must be called with g.mu held.
+func (g *HTTPGovernor) allow(host string, h *hostGovernor) (trial bool, err error) {
+	if g.failureThreshold == 0 {
+		return false, nil
+	}
+	switch h.state {
+	case circuitOpen:
+		if g.now().Sub(h.openedAt) < g.openTimeout {
+			return false, errors.Wrapf(ErrCircuitOpen, "too many failed requests to %s, failing fast until %s", host, h.openedAt.Add(g.openTimeout).Format(time.RFC3339))
+		}
+		g.setState(host, h, circuitHalfOpen)
+		fallthrough
+	case circuitHalfOpen:
+		if h.trial {
+			return false, errors.Wrapf(ErrCircuitOpen, "too many failed requests to %s, waiting for trial request", host)
+		}
+		h.trial = true
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (g *HTTPGovernor) abortTrial(h *hostGovernor, trial bool) {
+	if trial {
+		g.mu.Lock()
+		h.trial = false
+		g.mu.Unlock()
+	}
+}
+
+func (g *HTTPGovernor) record(host string, h *hostGovernor, trial bool, failed bool) {
+	if g.failureThreshold == 0 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if trial {
+		h.trial = false
+	}
+	if !failed {
+		h.failures = 0
+		if h.state != circuitClosed {
+			g.setState(host, h, circuitClosed)
+		}
+		return
+	}
+	h.failures++
+	if trial || h.failures >= g.failureThreshold {
+		h.openedAt = g.now()
+		g.setState(host, h, circuitOpen)
+	}
+}
+
+func (g *HTTPGovernor) setState(host string, h *hostGovernor, state circuitState) {
+	h.state = state
+	promHTTPCircuitBreakerState.WithLabelValues(host).Set(float64(state))
+}
+
+// isHostFailure returns true if the outcome of a request indicates that the
+// host is unavailable, as opposed to rejecting this particular request.
+func isHostFailure(statusCode int, err error) bool {
+	if statusCode >= 500 || statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if statusCode == 0 && err != nil {
+		return !errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP)
+	}
+	return false
+}
+
+// HealthReport reports an error for every host whose circuit breaker is not closed.
+func (g *HTTPGovernor) HealthReport(name string) map[string]error {
+	report := map[string]error{name: nil}
+	if g == nil {
+		return report
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for host, h := range g.hosts {
+		if h.state != circuitClosed {
+			report[name+"."+host] = errors.Errorf("circuit breaker %s after %d consecutive failures", h.state, h.failures)
+		}
+	}
+	return report
+}
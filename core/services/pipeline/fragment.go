@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Fragment is a named, versioned piece of pipeline which jobs invoke with the
+// `fragment` task. Versions are immutable: changing a fragment creates a new version.
+type Fragment struct {
+	ID           int32
+	Name         string
+	Version      int32
+	DotDagSource string
+	CreatedAt    time.Time
+}
+
+// maxFragmentDepth bounds how deeply fragments and map tasks may nest runs
+// within each other, which also breaks cycles.
+const maxFragmentDepth = 8
+
+var fragmentNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ValidateFragment checks that name is a valid fragment name, and that source
+// is a pipeline with a single final task, which fragment tasks return the output of.
+func ValidateFragment(name string, source string) error {
+	if !fragmentNameRegex.MatchString(name) {
+		return errors.Errorf("fragment name %q must contain only letters, digits, underscores and dashes", name)
+	}
+	return errors.Wrap(validateNestedPipeline(source), "invalid fragment pipeline")
+}
+
+// validateNestedPipeline checks that source can be run within another run, by
+// a fragment or map task, which return the output of its single final task.
+func validateNestedPipeline(source string) error {
+	p, err := Parse(source)
+	if err != nil {
+		return err
+	}
+	if p.RequiresPreInsert() {
+		return errors.New("pipeline must not contain ethtx or async bridge tasks")
+	}
+	var final []string
+	for _, task := range p.Tasks {
+		if len(task.Outputs()) == 0 {
+			final = append(final, task.DotID())
+		}
+	}
+	if len(final) != 1 {
+		return errors.Errorf("pipeline must have exactly one final task, got %v", final)
+	}
+	return nil
+}
+
+// loadFragment returns the given version of the fragment, or the latest if
+// version is 0, along with a spec to run it with the settings of parent.
+func loadFragment(ctx context.Context, orm ORM, parent Spec, name string, version int32) (Fragment, Spec, error) {
+	if orm == nil {
+		return Fragment{}, Spec{}, errors.New("pipeline fragments are not available")
+	}
+	fragment, err := orm.FindFragment(ctx, name, version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fragment, Spec{}, errors.Wrapf(ErrBadInput, "fragment %q version %d does not exist", name, version)
+	} else if err != nil {
+		return fragment, Spec{}, errors.Wrapf(err, "failed to load fragment %q", name)
+	}
+	return fragment, nestedSpec(parent, fragment.DotDagSource), nil
+}
+
+// nestedSpec returns a spec for running source within a run of parent.
+func nestedSpec(parent Spec, source string) Spec {
+	return Spec{
+		DotDagSource:      source,
+		MaxTaskDuration:   parent.MaxTaskDuration,
+		GasLimit:          parent.GasLimit,
+		ForwardingAllowed: parent.ForwardingAllowed,
+		JobID:             parent.JobID,
+		JobName:           parent.JobName,
+		JobType:           parent.JobType,
+	}
+}
+
+type fragmentDepthKey struct{}
+
+func fragmentDepth(ctx context.Context) int {
+	depth, _ := ctx.Value(fragmentDepthKey{}).(int)
+	return depth
+}
+
+func withFragmentDepth(ctx context.Context, depth int) context.Context {
+	return context.WithValue(ctx, fragmentDepthKey{}, depth)
+}
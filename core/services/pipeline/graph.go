@@ -252,6 +252,11 @@ func Parse(text string) (*Pipeline, error) {
 		if err != nil {
 			return nil, err
 		}
+		if m, is := task.(*MapTask); is {
+			if err = m.validate(); err != nil {
+				return nil, err
+			}
+		}
 
 		if task.OutputIndex() > 0 {
 			_, exists := resultIdxs[task.OutputIndex()]
@@ -3,11 +3,14 @@ package pipeline
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 const (
@@ -45,12 +48,42 @@ func (t *BridgeTask) HelperSetDependencies(
 	t.specId = specId
 }
 
+func (t *BridgeTask) HelperSetBridgeAuthenticator(a *bridges.Authenticator) {
+	t.bridgeAuth = a
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
 	t.config = config
 	t.httpClient = restrictedHTTPClient
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetHTTPCache(cache *HTTPCache) {
+	t.httpCache = cache
+}
+
+func (t *HTTPTask) HelperSetHTTPGovernor(governor *HTTPGovernor) {
+	t.httpGovernor = governor
+}
+
+func (t *BridgeTask) HelperSetHTTPGovernor(governor *HTTPGovernor) {
+	t.httpGovernor = governor
+}
+
+func (g *HTTPGovernor) HelperSetNow(now func() time.Time) {
+	g.now = now
+}
+
+func (g *HTTPGovernor) HelperHosts() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return maps.Keys(g.hosts)
+}
+
+func (c *HTTPCache) HelperSetNow(now func() time.Time) {
+	c.now = now
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -61,6 +94,7 @@ func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainCo
 func (t *ETHTxTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, keyStore ETHKeyStore, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.keyStore = keyStore
+	t.keySelectors = newKeySelectors(logger.NullLogger, keyStore)
 	t.specGasLimit = specGasLimit
 	t.jobType = jobType
 }
@@ -0,0 +1,820 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Limits of the evaluation of JSONPath queries, which keep queries of job
+// specs from exhausting the time and memory of the node.
+const (
+	// maxJSONQueryLength is the maximum length of a query
+	maxJSONQueryLength = 1024
+	// maxJSONQueryDepth is the maximum nesting of filters and parentheses
+	maxJSONQueryDepth = 16
+	// maxJSONQuerySteps is the maximum number of nodes a query may visit
+	maxJSONQuerySteps = 1_000_000
+	// maxJSONQueryNodes is the maximum number of nodes a query may select at any point
+	maxJSONQueryNodes = 100_000
+	// maxJSONQueryDuration is the maximum duration of an evaluation
+	maxJSONQueryDuration = time.Second
+)
+
+var ErrJSONQueryLimit = errors.New("JSONPath query exceeds evaluation limits")
+
+// JSONQuery is a JSONPath query as specified by RFC 9535, except for function
+// extensions. Members of objects are selected in the order of their names, so
+// that the results are deterministic. It is not built on gjson, whose path
+// syntax is not JSONPath and has no equivalent of filters on arbitrary
+// expressions, slices with steps or descendant segments.
+//
+// Examples:
+//
+//	$.data[?@.symbol == 'ETH'].price
+//	$.data[*].price
+//	$..price
+//	$.prices[-3:]
+type JSONQuery struct {
+	segments []jsonSegment
+}
+
+type jsonSegment struct {
+	descendant bool
+	selectors  []jsonSelector
+}
+
+type jsonSelector interface {
+	// selectJSON appends the children of node selected by the selector to nodes
+	selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error)
+}
+
+// ParseJSONQuery parses a JSONPath query, which must start with $.
+func ParseJSONQuery(query string) (JSONQuery, error) {
+	if len(query) > maxJSONQueryLength {
+		return JSONQuery{}, errors.Wrapf(ErrJSONQueryLimit, "query is longer than %d characters", maxJSONQueryLength)
+	}
+	p := jsonQueryParser{s: query}
+	p.skipSpace()
+	if !p.consume("$") {
+		return JSONQuery{}, p.errorf("query must start with $")
+	}
+	q, err := p.parseSegments()
+	if err != nil {
+		return JSONQuery{}, err
+	}
+	p.skipSpace()
+	if !p.done() {
+		return JSONQuery{}, p.errorf("unexpected %q", p.rest())
+	}
+	return q, nil
+}
+
+// Singular reports whether the query selects at most one node, i.e. it
+// consists only of single name and index selectors.
+func (q JSONQuery) Singular() bool {
+	for _, seg := range q.segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+		switch seg.selectors[0].(type) {
+		case jsonNameSelector, jsonIndexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate returns the nodes of the decoded JSON value selected by the query.
+// The evaluation fails with ErrJSONQueryLimit if it visits or selects too
+// many nodes, or takes too long.
+func (q JSONQuery) Evaluate(ctx context.Context, value interface{}) ([]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, maxJSONQueryDuration)
+	defer cancel()
+	e := &jsonQueryEval{ctx: ctx, root: value}
+	return e.evaluate(q, value)
+}
+
+type jsonQueryEval struct {
+	ctx   context.Context
+	root  interface{}
+	steps int
+}
+
+func (e *jsonQueryEval) step() error {
+	e.steps++
+	if e.steps > maxJSONQuerySteps {
+		return errors.Wrapf(ErrJSONQueryLimit, "query visits more than %d nodes", maxJSONQuerySteps)
+	}
+	if e.steps%1024 == 0 && e.ctx.Err() != nil {
+		return errors.Wrapf(ErrJSONQueryLimit, "query takes longer than %s: %v", maxJSONQueryDuration, e.ctx.Err())
+	}
+	return nil
+}
+
+func (e *jsonQueryEval) evaluate(q JSONQuery, node interface{}) ([]interface{}, error) {
+	nodes := []interface{}{node}
+	for _, seg := range q.segments {
+		var selected []interface{}
+		for _, n := range nodes {
+			var err error
+			if seg.descendant {
+				selected, err = e.selectDescendants(seg.selectors, n, selected)
+			} else {
+				selected, err = e.selectChildren(seg.selectors, n, selected)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		nodes = selected
+	}
+	return nodes, nil
+}
+
+func (e *jsonQueryEval) selectChildren(selectors []jsonSelector, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	for _, s := range selectors {
+		var err error
+		if nodes, err = s.selectJSON(e, node, nodes); err != nil {
+			return nil, err
+		}
+		if len(nodes) > maxJSONQueryNodes {
+			return nil, errors.Wrapf(ErrJSONQueryLimit, "query selects more than %d nodes", maxJSONQueryNodes)
+		}
+	}
+	return nodes, nil
+}
+
+// selectDescendants applies selectors to node and all of its descendants, in document order.
+func (e *jsonQueryEval) selectDescendants(selectors []jsonSelector, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	nodes, err := e.selectChildren(selectors, node, nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range jsonChildren(node) {
+		if err = e.step(); err != nil {
+			return nil, err
+		}
+		if nodes, err = e.selectDescendants(selectors, child, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// jsonChildren returns the elements of arrays, and the members of objects in the order of their names.
+func jsonChildren(node interface{}) []interface{} {
+	switch n := node.(type) {
+	case []interface{}:
+		return n
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		children := make([]interface{}, len(keys))
+		for i, k := range keys {
+			children[i] = n[k]
+		}
+		return children
+	default:
+		return nil
+	}
+}
+
+type jsonNameSelector string
+
+func (s jsonNameSelector) selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	if err := e.step(); err != nil {
+		return nil, err
+	}
+	if m, ok := node.(map[string]interface{}); ok {
+		if v, exists := m[string(s)]; exists {
+			nodes = append(nodes, v)
+		}
+	}
+	return nodes, nil
+}
+
+type jsonWildcardSelector struct{}
+
+func (jsonWildcardSelector) selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	for _, child := range jsonChildren(node) {
+		if err := e.step(); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, child)
+	}
+	return nodes, nil
+}
+
+type jsonIndexSelector int
+
+func (s jsonIndexSelector) selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	if err := e.step(); err != nil {
+		return nil, err
+	}
+	if a, ok := node.([]interface{}); ok {
+		i := int(s)
+		if i < 0 {
+			i += len(a)
+		}
+		if i >= 0 && i < len(a) {
+			nodes = append(nodes, a[i])
+		}
+	}
+	return nodes, nil
+}
+
+type jsonSliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s jsonSliceSelector) selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	a, ok := node.([]interface{})
+	if !ok || s.step == 0 {
+		return nodes, nil
+	}
+	n := len(a)
+	normalize := func(i int) int {
+		if i < 0 {
+			return i + n
+		}
+		return i
+	}
+	clamp := func(i, lower, upper int) int {
+		return min(max(i, lower), upper)
+	}
+	if s.step > 0 {
+		start, end := 0, n
+		if s.start != nil {
+			start = clamp(normalize(*s.start), 0, n)
+		}
+		if s.end != nil {
+			end = clamp(normalize(*s.end), 0, n)
+		}
+		for i := start; i < end; i += s.step {
+			if err := e.step(); err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, a[i])
+			// stop before i overflows with huge steps
+			if s.step >= end-i {
+				break
+			}
+		}
+		return nodes, nil
+	}
+	start, end := n-1, -1
+	if s.start != nil {
+		start = clamp(normalize(*s.start), -1, n-1)
+	}
+	if s.end != nil {
+		end = clamp(normalize(*s.end), -1, n-1)
+	}
+	for i := start; i > end; i += s.step {
+		if err := e.step(); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, a[i])
+		if s.step <= end-i {
+			break
+		}
+	}
+	return nodes, nil
+}
+
+type jsonFilterSelector struct {
+	expr jsonFilterExpr
+}
+
+func (s jsonFilterSelector) selectJSON(e *jsonQueryEval, node interface{}, nodes []interface{}) ([]interface{}, error) {
+	for _, child := range jsonChildren(node) {
+		if err := e.step(); err != nil {
+			return nil, err
+		}
+		ok, err := s.expr.test(e, child)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			nodes = append(nodes, child)
+		}
+	}
+	return nodes, nil
+}
+
+// jsonFilterExpr is a logical expression of a filter selector.
+type jsonFilterExpr interface {
+	test(e *jsonQueryEval, current interface{}) (bool, error)
+}
+
+type jsonOrExpr []jsonFilterExpr
+
+func (x jsonOrExpr) test(e *jsonQueryEval, current interface{}) (bool, error) {
+	for _, expr := range x {
+		if ok, err := expr.test(e, current); err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+type jsonAndExpr []jsonFilterExpr
+
+func (x jsonAndExpr) test(e *jsonQueryEval, current interface{}) (bool, error) {
+	for _, expr := range x {
+		if ok, err := expr.test(e, current); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+type jsonNotExpr struct {
+	expr jsonFilterExpr
+}
+
+func (x jsonNotExpr) test(e *jsonQueryEval, current interface{}) (bool, error) {
+	ok, err := x.expr.test(e, current)
+	return !ok, err
+}
+
+// jsonExistsExpr tests whether a query selects any nodes.
+type jsonExistsExpr struct {
+	query jsonFilterQuery
+}
+
+func (x jsonExistsExpr) test(e *jsonQueryEval, current interface{}) (bool, error) {
+	nodes, err := x.query.evaluate(e, current)
+	return len(nodes) > 0, err
+}
+
+// jsonFilterQuery is a query relative to the current node (@) or the root ($).
+type jsonFilterQuery struct {
+	relative bool
+	query    JSONQuery
+}
+
+func (q jsonFilterQuery) evaluate(e *jsonQueryEval, current interface{}) ([]interface{}, error) {
+	if q.relative {
+		return e.evaluate(q.query, current)
+	}
+	return e.evaluate(q.query, e.root)
+}
+
+// jsonComparable is either a literal, or a singular query.
+type jsonComparable struct {
+	literal interface{}
+	query   *jsonFilterQuery
+}
+
+// value returns the value of the comparable, and false if it is a query which selects no node.
+func (c jsonComparable) value(e *jsonQueryEval, current interface{}) (interface{}, bool, error) {
+	if c.query == nil {
+		return c.literal, true, nil
+	}
+	nodes, err := c.query.evaluate(e, current)
+	if err != nil || len(nodes) == 0 {
+		return nil, false, err
+	}
+	return nodes[0], true, nil
+}
+
+type jsonComparisonExpr struct {
+	left, right jsonComparable
+	op          string
+}
+
+func (x jsonComparisonExpr) test(e *jsonQueryEval, current interface{}) (bool, error) {
+	left, leftExists, err := x.left.value(e, current)
+	if err != nil {
+		return false, err
+	}
+	right, rightExists, err := x.right.value(e, current)
+	if err != nil {
+		return false, err
+	}
+	equal := func() bool {
+		if !leftExists || !rightExists {
+			return leftExists == rightExists
+		}
+		return jsonEqual(left, right)
+	}
+	less := func(a, b interface{}) bool {
+		return leftExists && rightExists && jsonLess(a, b)
+	}
+	switch x.op {
+	case "==":
+		return equal(), nil
+	case "!=":
+		return !equal(), nil
+	case "<":
+		return less(left, right), nil
+	case "<=":
+		return less(left, right) || equal(), nil
+	case ">":
+		return less(right, left), nil
+	case ">=":
+		return less(right, left) || equal(), nil
+	default:
+		return false, errors.Errorf("unknown comparison operator %q", x.op)
+	}
+}
+
+// jsonNumber returns the value of JSON numbers, which are json.Number or float64 when decoded.
+func jsonNumber(v interface{}) (decimal.Decimal, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		d, err := decimal.NewFromString(n.String())
+		return d, err == nil
+	case float64:
+		return decimal.NewFromFloat(n), true
+	default:
+		return decimal.Decimal{}, false
+	}
+}
+
+func jsonEqual(a, b interface{}) bool {
+	if x, ok := jsonNumber(a); ok {
+		y, ok := jsonNumber(b)
+		return ok && x.Equal(y)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func jsonLess(a, b interface{}) bool {
+	if x, ok := jsonNumber(a); ok {
+		y, ok := jsonNumber(b)
+		return ok && x.LessThan(y)
+	}
+	x, ok := a.(string)
+	if !ok {
+		return false
+	}
+	y, ok := b.(string)
+	return ok && x < y
+}
+
+type jsonQueryParser struct {
+	s     string
+	pos   int
+	depth int
+}
+
+func (p *jsonQueryParser) errorf(format string, args ...interface{}) error {
+	return errors.Wrapf(ErrBadInput, "invalid JSONPath query at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *jsonQueryParser) done() bool       { return p.pos >= len(p.s) }
+func (p *jsonQueryParser) rest() string     { return p.s[p.pos:] }
+func (p *jsonQueryParser) peek() byte       { return p.s[p.pos] }
+func (p *jsonQueryParser) at(s string) bool { return strings.HasPrefix(p.rest(), s) }
+
+func (p *jsonQueryParser) consume(s string) bool {
+	if p.at(s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *jsonQueryParser) skipSpace() {
+	for !p.done() && strings.IndexByte(" \t\n\r", p.peek()) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *jsonQueryParser) parseSegments() (JSONQuery, error) {
+	var q JSONQuery
+	for {
+		start := p.pos
+		p.skipSpace()
+		switch {
+		case p.consume(".."):
+			seg, err := p.parseShorthandOrBracket()
+			if err != nil {
+				return q, err
+			}
+			seg.descendant = true
+			q.segments = append(q.segments, seg)
+		case p.consume("."):
+			seg, err := p.parseShorthand()
+			if err != nil {
+				return q, err
+			}
+			q.segments = append(q.segments, seg)
+		case p.at("["):
+			seg, err := p.parseBracket()
+			if err != nil {
+				return q, err
+			}
+			q.segments = append(q.segments, seg)
+		default:
+			p.pos = start
+			return q, nil
+		}
+	}
+}
+
+func (p *jsonQueryParser) parseShorthandOrBracket() (jsonSegment, error) {
+	if p.at("[") {
+		return p.parseBracket()
+	}
+	return p.parseShorthand()
+}
+
+func (p *jsonQueryParser) parseShorthand() (jsonSegment, error) {
+	if p.consume("*") {
+		return jsonSegment{selectors: []jsonSelector{jsonWildcardSelector{}}}, nil
+	}
+	start := p.pos
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.rest())
+		isNameChar := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r >= 0x80 ||
+			(p.pos > start && r >= '0' && r <= '9')
+		if !isNameChar {
+			break
+		}
+		p.pos += size
+	}
+	if p.pos == start {
+		return jsonSegment{}, p.errorf("expected member name or *")
+	}
+	return jsonSegment{selectors: []jsonSelector{jsonNameSelector(p.s[start:p.pos])}}, nil
+}
+
+func (p *jsonQueryParser) parseBracket() (jsonSegment, error) {
+	var seg jsonSegment
+	p.consume("[")
+	for {
+		p.skipSpace()
+		s, err := p.parseSelector()
+		if err != nil {
+			return seg, err
+		}
+		seg.selectors = append(seg.selectors, s)
+		p.skipSpace()
+		if p.consume("]") {
+			return seg, nil
+		}
+		if !p.consume(",") {
+			return seg, p.errorf("expected , or ]")
+		}
+	}
+}
+
+func (p *jsonQueryParser) parseSelector() (jsonSelector, error) {
+	if p.done() {
+		return nil, p.errorf("unexpected end of query")
+	}
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		return jsonNameSelector(name), err
+	case c == '*':
+		p.pos++
+		return jsonWildcardSelector{}, nil
+	case c == '?':
+		p.pos++
+		expr, err := p.parseLogicalOr()
+		return jsonFilterSelector{expr: expr}, err
+	default:
+		return p.parseIndexOrSlice()
+	}
+}
+
+func (p *jsonQueryParser) parseIndexOrSlice() (jsonSelector, error) {
+	var parts [3]*int
+	i := 0
+	for {
+		p.skipSpace()
+		if !p.at(":") && !p.at("]") && !p.at(",") {
+			n, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			parts[i] = &n
+		}
+		p.skipSpace()
+		if i == 0 && !p.at(":") {
+			if parts[0] == nil {
+				return nil, p.errorf("expected selector")
+			}
+			return jsonIndexSelector(*parts[0]), nil
+		}
+		if i == 2 || !p.consume(":") {
+			break
+		}
+		i++
+	}
+	step := 1
+	if parts[2] != nil {
+		step = *parts[2]
+	}
+	return jsonSliceSelector{start: parts[0], end: parts[1], step: step}, nil
+}
+
+func (p *jsonQueryParser) parseInt() (int, error) {
+	start := p.pos
+	p.consume("-")
+	for !p.done() && p.peek() >= '0' && p.peek() <= '9' {
+		p.pos++
+	}
+	n, err := strconv.Atoi(p.s[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("expected integer")
+	}
+	return n, nil
+}
+
+func (p *jsonQueryParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var sb strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return sb.String(), nil
+		case c == '\\':
+			if p.done() {
+				return "", p.errorf("unterminated string")
+			}
+			esc := p.peek()
+			p.pos++
+			switch esc {
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if len(p.rest()) < 4 {
+					return "", p.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.s[p.pos:p.pos+4], 16, 16)
+				if err != nil {
+					return "", p.errorf("invalid unicode escape")
+				}
+				sb.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				sb.WriteByte(esc)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *jsonQueryParser) enter() error {
+	p.depth++
+	if p.depth > maxJSONQueryDepth {
+		return errors.Wrapf(ErrJSONQueryLimit, "filters are nested more than %d levels deep", maxJSONQueryDepth)
+	}
+	return nil
+}
+
+func (p *jsonQueryParser) parseLogicalOr() (jsonFilterExpr, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+	var or jsonOrExpr
+	for {
+		expr, err := p.parseLogicalAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, expr)
+		p.skipSpace()
+		if !p.consume("||") {
+			break
+		}
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func (p *jsonQueryParser) parseLogicalAnd() (jsonFilterExpr, error) {
+	var and jsonAndExpr
+	for {
+		expr, err := p.parseBasic()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, expr)
+		p.skipSpace()
+		if !p.consume("&&") {
+			break
+		}
+	}
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+func (p *jsonQueryParser) parseBasic() (jsonFilterExpr, error) {
+	p.skipSpace()
+	if p.consume("!") {
+		p.skipSpace()
+		if !p.at("(") && !p.at("@") && !p.at("$") {
+			return nil, p.errorf("expected ( or query after !")
+		}
+		expr, err := p.parseBasic()
+		return jsonNotExpr{expr: expr}, err
+	}
+	if p.consume("(") {
+		expr, err := p.parseLogicalOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return expr, nil
+	}
+
+	left, err := p.parseComparable()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.consume(op) {
+			continue
+		}
+		p.skipSpace()
+		right, err := p.parseComparable()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range []jsonComparable{left, right} {
+			if c.query != nil && !c.query.query.Singular() {
+				return nil, p.errorf("only singular queries can be compared")
+			}
+		}
+		return jsonComparisonExpr{left: left, right: right, op: op}, nil
+	}
+	if left.query == nil {
+		return nil, p.errorf("expected comparison")
+	}
+	return jsonExistsExpr{query: *left.query}, nil
+}
+
+func (p *jsonQueryParser) parseComparable() (jsonComparable, error) {
+	if p.done() {
+		return jsonComparable{}, p.errorf("unexpected end of query")
+	}
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		q, err := p.parseSegments()
+		return jsonComparable{query: &jsonFilterQuery{relative: c == '@', query: q}}, err
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return jsonComparable{literal: s}, err
+	case p.consume("true"):
+		return jsonComparable{literal: true}, nil
+	case p.consume("false"):
+		return jsonComparable{literal: false}, nil
+	case p.consume("null"):
+		return jsonComparable{literal: nil}, nil
+	default:
+		start := p.pos
+		for !p.done() && strings.IndexByte("-+.eE0123456789", p.peek()) >= 0 {
+			p.pos++
+		}
+		n := json.Number(p.s[start:p.pos])
+		if _, err := n.Float64(); err != nil {
+			p.pos = start
+			return jsonComparable{}, p.errorf("expected literal or query")
+		}
+		return jsonComparable{literal: n}, nil
+	}
+}
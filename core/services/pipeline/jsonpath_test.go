@@ -0,0 +1,147 @@
+package pipeline_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQuery(t *testing.T) {
+	t.Parallel()
+
+	doc := `{
+		"store": {
+			"book": [
+				{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+				{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+				{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+				{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+			],
+			"bicycle": {"color": "red", "price": 399}
+		},
+		"limit": 10,
+		"o": {"j j": {"k.k": 3}, "a": 1, "b": 2}
+	}`
+	var value interface{}
+	d := json.NewDecoder(bytes.NewReader([]byte(doc)))
+	d.UseNumber()
+	require.NoError(t, d.Decode(&value))
+
+	tests := []struct {
+		query    string
+		want     string
+		singular bool
+	}{
+		{`$`, `[` + doc + `]`, true},
+		{`$.store.book[*].author`, `["Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"]`, false},
+		{`$..author`, `["Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"]`, false},
+		{`$.store.*.color`, `["red"]`, false},
+		{`$.store..price`, `[399, 8.95, 12.99, 8.99, 22.99]`, false},
+		{`$..book[2].title`, `["Moby Dick"]`, false},
+		{`$..book[-1].title`, `["The Lord of the Rings"]`, false},
+		{`$.store.book[0,1].title`, `["Sayings of the Century", "Sword of Honour"]`, false},
+		{`$.store.book[:2].title`, `["Sayings of the Century", "Sword of Honour"]`, false},
+		{`$.store.book[1:3].price`, `[12.99, 8.99]`, false},
+		{`$.store.book[::-2].price`, `[22.99, 12.99]`, false},
+		{`$.store.book[1::9223372036854775807].price`, `[12.99]`, false},
+		{`$.store.book[2::-9223372036854775807].price`, `[8.99]`, false},
+		{`$.store.book[::-9223372036854775808].price`, `[22.99]`, false},
+		{`$.store.book[?@.isbn].title`, `["Moby Dick", "The Lord of the Rings"]`, false},
+		{`$.store.book[?!@.isbn].title`, `["Sayings of the Century", "Sword of Honour"]`, false},
+		{`$.store.book[?@.price < 10].title`, `["Sayings of the Century", "Moby Dick"]`, false},
+		{`$.store.book[?@.price < $.limit].price`, `[8.95, 8.99]`, false},
+		{`$.store.book[?@.category == "fiction" && (@.price > 20 || @.price == 12.99)].title`, `["Sword of Honour", "The Lord of the Rings"]`, false},
+		{`$.store.book[?@.author >= 'J'].author`, `["Nigel Rees", "J. R. R. Tolkien"]`, false},
+		{`$..[?@.color == 'red'].price`, `[399]`, false},
+		{`$.o['j j']['k.k']`, `[3]`, true},
+		{`$.o.*`, `[1, 2, {"k.k": 3}]`, false},
+		{`$.store.book[0].title`, `["Sayings of the Century"]`, true},
+		{`$.store.book[10].title`, `[]`, true},
+		{`$.nope`, `[]`, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.query, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := pipeline.ParseJSONQuery(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.singular, q.Singular())
+			nodes, err := q.Evaluate(testutils.Context(t), value)
+			require.NoError(t, err)
+			if nodes == nil {
+				nodes = []interface{}{}
+			}
+			b, err := json.Marshal(nodes)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(b))
+		})
+	}
+}
+
+func TestJSONQuery_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, query := range []string{
+		``,
+		`store`,
+		`$.`,
+		`$.1a`,
+		`$[`,
+		`$['a'`,
+		`$[1 2]`,
+		`$[?@.a == ]`,
+		`$[?@.a = 1]`,
+		`$[?1 == 1 &&]`,
+		`$[?(@.a == 1]`,
+		`$[?@.a[*] == 1]`,
+		`$[?'a']`,
+		`$.a b`,
+	} {
+		_, err := pipeline.ParseJSONQuery(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestJSONQuery_Limits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query too long", func(t *testing.T) {
+		_, err := pipeline.ParseJSONQuery("$" + strings.Repeat(".a", 1000))
+		require.Equal(t, pipeline.ErrJSONQueryLimit, errors.Cause(err))
+	})
+
+	t.Run("filters nested too deeply", func(t *testing.T) {
+		_, err := pipeline.ParseJSONQuery("$[?" + strings.Repeat("@[?", 20) + "@" + strings.Repeat("]", 20) + "]")
+		require.Equal(t, pipeline.ErrJSONQueryLimit, errors.Cause(err))
+	})
+
+	t.Run("too many nodes visited", func(t *testing.T) {
+		// every element is visited for every element by the nested filter
+		arr := make([]interface{}, 2000)
+		for i := range arr {
+			arr[i] = json.Number("1")
+		}
+		q, err := pipeline.ParseJSONQuery(`$[?$[?@ == 2]]`)
+		require.NoError(t, err)
+		_, err = q.Evaluate(testutils.Context(t), arr)
+		require.Equal(t, pipeline.ErrJSONQueryLimit, errors.Cause(err))
+	})
+
+	t.Run("too many nodes selected", func(t *testing.T) {
+		arr := make([]interface{}, 200_000)
+		q, err := pipeline.ParseJSONQuery(`$[*]`)
+		require.NoError(t, err)
+		_, err = q.Evaluate(testutils.Context(t), arr)
+		require.Equal(t, pipeline.ErrJSONQueryLimit, errors.Cause(err))
+	})
+}
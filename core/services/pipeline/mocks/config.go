@@ -112,6 +112,366 @@ func (_c *Config_DefaultHTTPTimeout_Call) RunAndReturn(run func() config.Duratio
 	return _c
 }
 
+// HTTPCacheEnabled provides a mock function with given fields:
+func (_m *Config) HTTPCacheEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCacheEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Config_HTTPCacheEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCacheEnabled'
+type Config_HTTPCacheEnabled_Call struct {
+	*mock.Call
+}
+
+// HTTPCacheEnabled is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPCacheEnabled() *Config_HTTPCacheEnabled_Call {
+	return &Config_HTTPCacheEnabled_Call{Call: _e.mock.On("HTTPCacheEnabled")}
+}
+
+func (_c *Config_HTTPCacheEnabled_Call) Run(run func()) *Config_HTTPCacheEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCacheEnabled_Call) Return(_a0 bool) *Config_HTTPCacheEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCacheEnabled_Call) RunAndReturn(run func() bool) *Config_HTTPCacheEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPCacheMaxStaleOnError provides a mock function with given fields:
+func (_m *Config) HTTPCacheMaxStaleOnError() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCacheMaxStaleOnError")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_HTTPCacheMaxStaleOnError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCacheMaxStaleOnError'
+type Config_HTTPCacheMaxStaleOnError_Call struct {
+	*mock.Call
+}
+
+// HTTPCacheMaxStaleOnError is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPCacheMaxStaleOnError() *Config_HTTPCacheMaxStaleOnError_Call {
+	return &Config_HTTPCacheMaxStaleOnError_Call{Call: _e.mock.On("HTTPCacheMaxStaleOnError")}
+}
+
+func (_c *Config_HTTPCacheMaxStaleOnError_Call) Run(run func()) *Config_HTTPCacheMaxStaleOnError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCacheMaxStaleOnError_Call) Return(_a0 time.Duration) *Config_HTTPCacheMaxStaleOnError_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCacheMaxStaleOnError_Call) RunAndReturn(run func() time.Duration) *Config_HTTPCacheMaxStaleOnError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPCacheTTL provides a mock function with given fields:
+func (_m *Config) HTTPCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCacheTTL")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_HTTPCacheTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCacheTTL'
+type Config_HTTPCacheTTL_Call struct {
+	*mock.Call
+}
+
+// HTTPCacheTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPCacheTTL() *Config_HTTPCacheTTL_Call {
+	return &Config_HTTPCacheTTL_Call{Call: _e.mock.On("HTTPCacheTTL")}
+}
+
+func (_c *Config_HTTPCacheTTL_Call) Run(run func()) *Config_HTTPCacheTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCacheTTL_Call) Return(_a0 time.Duration) *Config_HTTPCacheTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCacheTTL_Call) RunAndReturn(run func() time.Duration) *Config_HTTPCacheTTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPCircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *Config) HTTPCircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCircuitBreakerFailureThreshold")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_HTTPCircuitBreakerFailureThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCircuitBreakerFailureThreshold'
+type Config_HTTPCircuitBreakerFailureThreshold_Call struct {
+	*mock.Call
+}
+
+// HTTPCircuitBreakerFailureThreshold is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPCircuitBreakerFailureThreshold() *Config_HTTPCircuitBreakerFailureThreshold_Call {
+	return &Config_HTTPCircuitBreakerFailureThreshold_Call{Call: _e.mock.On("HTTPCircuitBreakerFailureThreshold")}
+}
+
+func (_c *Config_HTTPCircuitBreakerFailureThreshold_Call) Run(run func()) *Config_HTTPCircuitBreakerFailureThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCircuitBreakerFailureThreshold_Call) Return(_a0 uint32) *Config_HTTPCircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCircuitBreakerFailureThreshold_Call) RunAndReturn(run func() uint32) *Config_HTTPCircuitBreakerFailureThreshold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPCircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *Config) HTTPCircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPCircuitBreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_HTTPCircuitBreakerOpenTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPCircuitBreakerOpenTimeout'
+type Config_HTTPCircuitBreakerOpenTimeout_Call struct {
+	*mock.Call
+}
+
+// HTTPCircuitBreakerOpenTimeout is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPCircuitBreakerOpenTimeout() *Config_HTTPCircuitBreakerOpenTimeout_Call {
+	return &Config_HTTPCircuitBreakerOpenTimeout_Call{Call: _e.mock.On("HTTPCircuitBreakerOpenTimeout")}
+}
+
+func (_c *Config_HTTPCircuitBreakerOpenTimeout_Call) Run(run func()) *Config_HTTPCircuitBreakerOpenTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPCircuitBreakerOpenTimeout_Call) Return(_a0 time.Duration) *Config_HTTPCircuitBreakerOpenTimeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPCircuitBreakerOpenTimeout_Call) RunAndReturn(run func() time.Duration) *Config_HTTPCircuitBreakerOpenTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPHostBurst provides a mock function with given fields:
+func (_m *Config) HTTPHostBurst() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPHostBurst")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_HTTPHostBurst_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPHostBurst'
+type Config_HTTPHostBurst_Call struct {
+	*mock.Call
+}
+
+// HTTPHostBurst is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPHostBurst() *Config_HTTPHostBurst_Call {
+	return &Config_HTTPHostBurst_Call{Call: _e.mock.On("HTTPHostBurst")}
+}
+
+func (_c *Config_HTTPHostBurst_Call) Run(run func()) *Config_HTTPHostBurst_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPHostBurst_Call) Return(_a0 int) *Config_HTTPHostBurst_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPHostBurst_Call) RunAndReturn(run func() int) *Config_HTTPHostBurst_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPHostMaxConcurrency provides a mock function with given fields:
+func (_m *Config) HTTPHostMaxConcurrency() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPHostMaxConcurrency")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// Config_HTTPHostMaxConcurrency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPHostMaxConcurrency'
+type Config_HTTPHostMaxConcurrency_Call struct {
+	*mock.Call
+}
+
+// HTTPHostMaxConcurrency is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPHostMaxConcurrency() *Config_HTTPHostMaxConcurrency_Call {
+	return &Config_HTTPHostMaxConcurrency_Call{Call: _e.mock.On("HTTPHostMaxConcurrency")}
+}
+
+func (_c *Config_HTTPHostMaxConcurrency_Call) Run(run func()) *Config_HTTPHostMaxConcurrency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPHostMaxConcurrency_Call) Return(_a0 uint32) *Config_HTTPHostMaxConcurrency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPHostMaxConcurrency_Call) RunAndReturn(run func() uint32) *Config_HTTPHostMaxConcurrency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HTTPHostRPS provides a mock function with given fields:
+func (_m *Config) HTTPHostRPS() float64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HTTPHostRPS")
+	}
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// Config_HTTPHostRPS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HTTPHostRPS'
+type Config_HTTPHostRPS_Call struct {
+	*mock.Call
+}
+
+// HTTPHostRPS is a helper method to define mock.On call
+func (_e *Config_Expecter) HTTPHostRPS() *Config_HTTPHostRPS_Call {
+	return &Config_HTTPHostRPS_Call{Call: _e.mock.On("HTTPHostRPS")}
+}
+
+func (_c *Config_HTTPHostRPS_Call) Run(run func()) *Config_HTTPHostRPS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_HTTPHostRPS_Call) Return(_a0 float64) *Config_HTTPHostRPS_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Config_HTTPHostRPS_Call) RunAndReturn(run func() float64) *Config_HTTPHostRPS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MaxRunDuration provides a mock function with given fields:
 func (_m *Config) MaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -26,6 +26,7 @@ type Spec struct {
 	MaxTaskDuration   models.Interval `json:"-"`
 	GasLimit          *uint32         `json:"-"`
 	ForwardingAllowed bool            `json:"-"`
+	CaptureResponses  bool            `json:"-"`
 
 	JobID   int32  `json:"-"`
 	JobName string `json:"-"`
@@ -64,6 +65,8 @@ type Run struct {
 	FinishedAt       null.Time                         `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun                         `json:"taskRuns"`
 	State            RunStatus                         `json:"state"`
+	// Capture holds the raw task responses needed to replay the run, if the job captures responses
+	Capture *SimulationFixtures `json:"-" db:"-"`
 
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
@@ -275,9 +278,14 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// BridgeName is the resolved name of the bridge that a pending bridge task run sent its request to. It is used
+	// to authenticate the callback resuming the task run.
+	BridgeName null.String `json:"-"`
 
 	// Used internally for sorting completed results
 	task Task
+	// Set on the task runs of the individual elements of map tasks
+	element bool
 }
 
 func (tr TaskRun) GetID() string {
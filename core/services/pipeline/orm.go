@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -92,9 +93,21 @@ type ORM interface {
 
 	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(ctx context.Context, id int64) (Run, error)
+	FindTaskRun(ctx context.Context, id uuid.UUID) (TaskRun, error)
+	// FindRunCapture returns the responses captured for replaying a run, or sql.ErrNoRows if there are none.
+	FindRunCapture(ctx context.Context, runID int64) (SimulationFixtures, error)
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
+	// CreateFragment stores source as the next version of the named fragment.
+	CreateFragment(ctx context.Context, name string, source string) (Fragment, error)
+	// FindFragment returns the given version of the named fragment, or the latest version if version is 0.
+	FindFragment(ctx context.Context, name string, version int32) (Fragment, error)
+	// FindFragments returns the latest version of every fragment, one page at a time.
+	FindFragments(ctx context.Context, offset, limit int) ([]Fragment, int, error)
+	// DeleteFragment deletes all versions of the named fragment.
+	DeleteFragment(ctx context.Context, name string) error
+
 	DataSource() sqlutil.DataSource
 	WithDataSource(sqlutil.DataSource) ORM
 	Transact(context.Context, func(ORM) error) error
@@ -212,8 +225,8 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, bridge_name)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :bridge_name);`
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -289,11 +302,14 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 			if _, err = tx.ds.NamedExecContext(ctx, sql, run); err != nil {
 				return fmt.Errorf("failed to update pipeline run %d: %w", run.ID, err)
 			}
+			if err = tx.insertRunCapture(ctx, run); err != nil {
+				return err
+			}
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, bridge_name)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :bridge_name)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
 		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
 		RETURNING *;
@@ -387,9 +403,13 @@ RETURNING id
 		pruningKeysm := make(map[int32]struct{})
 		for i, run := range runs {
 			pruningKeysm[run.PruningKey] = struct{}{}
+			run.ID = runIDs[i]
 			for j := range run.PipelineTaskRuns {
 				run.PipelineTaskRuns[j].PipelineRunID = runIDs[i]
 			}
+			if err = tx.insertRunCapture(ctx, run); err != nil {
+				return err
+			}
 		}
 
 		defer func() {
@@ -404,7 +424,7 @@ VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
-			if !saveSuccessfulTaskRuns && !run.HasErrors() {
+			if !saveSuccessfulTaskRuns && !run.HasErrors() && run.Capture == nil {
 				continue
 			}
 			pipelineTaskRuns = append(pipelineTaskRuns, run.PipelineTaskRuns...)
@@ -499,7 +519,12 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 		run.PipelineTaskRuns[i].PipelineRunID = run.ID
 	}
 
-	if !saveSuccessfulTaskRuns && !run.HasErrors() {
+	if err = o.insertRunCapture(ctx, run); err != nil {
+		return err
+	}
+
+	// captured runs keep their task runs, so that replays can be compared task by task
+	if !saveSuccessfulTaskRuns && !run.HasErrors() && run.Capture == nil {
 		return nil
 	}
 
@@ -580,6 +605,80 @@ func (o *orm) FindRun(ctx context.Context, id int64) (r Run, err error) {
 	return *runs[0], err
 }
 
+func (o *orm) FindTaskRun(ctx context.Context, id uuid.UUID) (tr TaskRun, err error) {
+	err = o.ds.GetContext(ctx, &tr, `SELECT * FROM pipeline_task_runs WHERE id = $1`, id)
+	return tr, err
+}
+
+func (o *orm) insertRunCapture(ctx context.Context, run *Run) error {
+	if run.Capture == nil {
+		return nil
+	}
+	capture, err := json.Marshal(run.Capture)
+	if err != nil {
+		// the capture is only a debugging aid, it must not fail storing the run
+		o.lggr.Errorw("Skipping capture of pipeline run which could not be encoded", "runID", run.ID, "err", err)
+		return nil
+	}
+	_, err = o.ds.ExecContext(ctx, `INSERT INTO pipeline_run_captures (pipeline_run_id, capture, created_at) VALUES ($1, $2, NOW())`, run.ID, capture)
+	return errors.Wrapf(err, "failed to insert capture of pipeline run %d", run.ID)
+}
+
+func (o *orm) FindRunCapture(ctx context.Context, runID int64) (fixtures SimulationFixtures, err error) {
+	var capture []byte
+	if err = o.ds.GetContext(ctx, &capture, `SELECT capture FROM pipeline_run_captures WHERE pipeline_run_id = $1`, runID); err != nil {
+		return fixtures, err
+	}
+	return ParseSimulationFixtures(capture)
+}
+
+func (o *orm) CreateFragment(ctx context.Context, name string, source string) (f Fragment, err error) {
+	err = o.transact(ctx, func(tx *orm) error {
+		// serialize the creation of versions of the same fragment, which would otherwise get the same version
+		if _, err = tx.ds.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('pipeline_fragments'), hashtext($1))`, name); err != nil {
+			return errors.Wrap(err, "failed to lock fragment")
+		}
+		sql := `INSERT INTO pipeline_fragments (name, version, dot_dag_source, created_at)
+		SELECT $1, coalesce(max(version), 0) + 1, $2, NOW() FROM pipeline_fragments WHERE name = $1
+		RETURNING *;`
+		return tx.ds.GetContext(ctx, &f, sql, name, source)
+	})
+	return f, errors.Wrapf(err, "failed to create fragment %q", name)
+}
+
+func (o *orm) FindFragment(ctx context.Context, name string, version int32) (f Fragment, err error) {
+	if version == 0 {
+		err = o.ds.GetContext(ctx, &f, `SELECT * FROM pipeline_fragments WHERE name = $1 ORDER BY version DESC LIMIT 1`, name)
+	} else {
+		err = o.ds.GetContext(ctx, &f, `SELECT * FROM pipeline_fragments WHERE name = $1 AND version = $2`, name, version)
+	}
+	return f, err
+}
+
+func (o *orm) FindFragments(ctx context.Context, offset, limit int) (fragments []Fragment, count int, err error) {
+	err = o.transact(ctx, func(tx *orm) error {
+		if err = tx.ds.GetContext(ctx, &count, `SELECT count(DISTINCT name) FROM pipeline_fragments`); err != nil {
+			return errors.Wrap(err, "failed to count fragments")
+		}
+		sql := `SELECT DISTINCT ON (name) * FROM pipeline_fragments ORDER BY name ASC, version DESC LIMIT $1 OFFSET $2;`
+		return errors.Wrap(tx.ds.SelectContext(ctx, &fragments, sql, limit, offset), "failed to load fragments")
+	})
+	return
+}
+
+func (o *orm) DeleteFragment(ctx context.Context, name string) error {
+	res, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_fragments WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete fragment %q", name)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) GetAllRuns(ctx context.Context) (runs []Run, err error) {
 	var runsPtrs []*Run
 	err = o.transact(ctx, func(tx *orm) error {
@@ -647,7 +746,8 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 			ps.max_task_duration,
 			coalesce(jobs.id, 0) "job_id",
 			coalesce(jobs.name, '') "job_name",
-			coalesce(jobs.type, '') "job_type"
+			coalesce(jobs.type, '') "job_type",
+			coalesce(jobs.capture_responses, false) "capture_responses"
 		FROM pipeline_specs ps
 		LEFT JOIN job_pipeline_specs jps ON jps.pipeline_spec_id=ps.id
 		LEFT JOIN jobs ON jobs.id=jps.job_id
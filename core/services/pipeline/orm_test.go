@@ -2,6 +2,9 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -241,6 +244,128 @@ func TestInsertFinishedRuns(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_PipelineORM_RunCapture(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, _ := setupLiteORM(t)
+
+	_, err := db.Exec(`SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	require.NoError(t, err)
+
+	ps := cltest.MustInsertPipelineSpec(t, db)
+	now := time.Now()
+	run := &pipeline.Run{
+		PipelineSpecID: ps.ID,
+		PruningKey:     ps.ID,
+		State:          pipeline.RunStatusCompleted,
+		AllErrors:      pipeline.RunErrors{null.String{}},
+		FatalErrors:    pipeline.RunErrors{null.String{}},
+		CreatedAt:      now,
+		FinishedAt:     null.TimeFrom(now.Add(100 * time.Millisecond)),
+		Outputs:        jsonserializable.JSONSerializable{Val: []interface{}{"100"}, Valid: true},
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{
+				ID:         uuid.New(),
+				Type:       pipeline.TaskTypeHTTP,
+				DotID:      "ds",
+				Output:     jsonserializable.JSONSerializable{Val: `{"price":100}`, Valid: true},
+				CreatedAt:  now,
+				FinishedAt: null.TimeFrom(now.Add(100 * time.Millisecond)),
+			},
+		},
+		Capture: &pipeline.SimulationFixtures{
+			Vars:  map[string]interface{}{"url": "https://example.com"},
+			Tasks: map[string]pipeline.SimulationFixture{"ds": {Value: `{"price":100}`}},
+		},
+	}
+
+	// captured runs keep their task runs even if successful task runs are not saved
+	require.NoError(t, orm.InsertFinishedRun(ctx, run, false))
+
+	found, err := orm.FindRun(ctx, run.ID)
+	require.NoError(t, err)
+	require.Len(t, found.PipelineTaskRuns, 1)
+
+	capture, err := orm.FindRunCapture(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, *run.Capture, capture)
+
+	require.NoError(t, orm.DeleteRun(ctx, run.ID))
+	_, err = orm.FindRunCapture(ctx, run.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// a capture which cannot be encoded is skipped, but the run is stored
+	run.ID = 0
+	run.PipelineTaskRuns[0].ID = uuid.New()
+	run.Capture = &pipeline.SimulationFixtures{Vars: map[string]interface{}{"nan": math.NaN()}}
+	require.NoError(t, orm.InsertFinishedRun(ctx, run, false))
+	_, err = orm.FindRun(ctx, run.ID)
+	require.NoError(t, err)
+	_, err = orm.FindRunCapture(ctx, run.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func Test_PipelineORM_Fragments(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, _ := setupLiteORM(t)
+
+	v1, err := orm.CreateFragment(ctx, "scale", `a [type=multiply input="$(params.x)" times=10];`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), v1.Version)
+	v2, err := orm.CreateFragment(ctx, "scale", `a [type=multiply input="$(params.x)" times=100];`)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), v2.Version)
+	_, err = orm.CreateFragment(ctx, "other", `a [type=memo value=1];`)
+	require.NoError(t, err)
+
+	latest, err := orm.FindFragment(ctx, "scale", 0)
+	require.NoError(t, err)
+	assert.Equal(t, v2, latest)
+	pinned, err := orm.FindFragment(ctx, "scale", 1)
+	require.NoError(t, err)
+	assert.Equal(t, v1, pinned)
+	_, err = orm.FindFragment(ctx, "scale", 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	fragments, count, err := orm.FindFragments(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, fragments, 2)
+	assert.Equal(t, "other", fragments[0].Name)
+	assert.Equal(t, v2, fragments[1])
+
+	require.NoError(t, orm.DeleteFragment(ctx, "scale"))
+	_, err = orm.FindFragment(ctx, "scale", 0)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.ErrorIs(t, orm.DeleteFragment(ctx, "scale"), sql.ErrNoRows)
+}
+
+func Test_PipelineORM_CreateFragment_Concurrent(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm, _ := setupORM(t, true)
+
+	const n = 10
+	versions := make(chan int32, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f, err := orm.CreateFragment(ctx, "scale", `a [type=multiply input="$(params.x)" times=10];`)
+			if assert.NoError(t, err) {
+				versions <- f.Version
+			}
+		}()
+	}
+	wg.Wait()
+	close(versions)
+
+	var got []int32
+	for v := range versions {
+		got = append(got, v)
+	}
+	assert.ElementsMatch(t, []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, got)
+}
+
 func Test_PipelineORM_InsertFinishedRunWithSpec(t *testing.T) {
 	ctx := testutils.Context(t)
 	db, orm, jorm := setupLiteORM(t)
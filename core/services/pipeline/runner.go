@@ -68,6 +68,13 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *HTTPCache
+	httpGovernor           *HTTPGovernor
+	keySelectors           *keySelectors
+	bridgeAuth             *bridges.Authenticator
+	bridgeHealth           *bridges.HealthChecker
+	// fixtures are set if the runner simulates runs
+	fixtures *SimulationFixtures
 
 	// test helper
 	runFinished func(*Run)
@@ -133,6 +140,9 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              NewHTTPCache(),
+		httpGovernor:           NewHTTPGovernor(cfg),
+		keySelectors:           newKeySelectors(lggr, ethks),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -182,6 +192,7 @@ func (r *runner) Name() string {
 
 func (r *runner) HealthReport() map[string]error {
 	runnerHealth := map[string]error{r.Name(): r.Healthy()}
+	services.CopyHealth(runnerHealth, r.httpGovernor.HealthReport(r.Name()+".HTTPGovernor"))
 
 	service, isService := r.btORM.(services.HealthReporter)
 	if !isService {
@@ -253,6 +264,18 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+// SetBridgeAuthenticator sets the authenticator bridge tasks use for bridges
+// with an auth scheme. Such bridges cannot be used without it.
+func (r *runner) SetBridgeAuthenticator(a *bridges.Authenticator) {
+	r.bridgeAuth = a
+}
+
+// SetBridgeHealthChecker sets the health checker bridge tasks use to order
+// the endpoints of bridges. Without it, endpoints are tried in their order.
+func (r *runner) SetBridgeHealthChecker(h *bridges.HealthChecker) {
+	r.bridgeHealth = h
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -337,6 +360,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).httpCache = r.httpCache
+			task.(*HTTPTask).httpGovernor = r.httpGovernor
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -347,6 +372,17 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).httpGovernor = r.httpGovernor
+			task.(*BridgeTask).bridgeAuth = r.bridgeAuth
+			task.(*BridgeTask).bridgeHealth = r.bridgeHealth
+		case TaskTypeFragment:
+			task.(*FragmentTask).spec = spec
+			task.(*FragmentTask).orm = r.orm
+			task.(*FragmentTask).executeRun = r.ExecuteRun
+		case TaskTypeMap:
+			task.(*MapTask).spec = spec
+			task.(*MapTask).orm = r.orm
+			task.(*MapTask).executeRun = r.ExecuteRun
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -365,6 +401,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).legacyChains = r.legacyEVMChains
+			task.(*ETHTxTask).keySelectors = r.keySelectors
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
@@ -379,6 +416,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	var capture *SimulationFixtures
+	if run.PipelineSpec.CaptureResponses && r.fixtures == nil {
+		capture = newRunCapture(vars)
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -442,8 +484,24 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			BridgeName:    null.NewString(result.runInfo.bridgeName, result.runInfo.bridgeName != ""),
 			task:          result.Task,
 		})
+		for i, element := range result.runInfo.elements {
+			run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
+				ID:            element.ID,
+				PipelineRunID: run.ID,
+				Type:          result.Task.Type(),
+				Index:         result.Task.OutputIndex(),
+				Output:        element.Result.OutputDB(),
+				Error:         element.Result.ErrorDB(),
+				DotID:         mapElementDotID(result.Task.DotID(), i),
+				CreatedAt:     element.CreatedAt,
+				FinishedAt:    element.FinishedAt,
+				task:          result.Task,
+				element:       true,
+			})
+		}
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
 			if run.PipelineTaskRuns[i].task.OutputIndex() == run.PipelineTaskRuns[j].task.OutputIndex() {
@@ -459,6 +517,10 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		var fatalErrors []null.String
 		var outputs []interface{}
 		for _, result := range run.PipelineTaskRuns {
+			// elements of map tasks are reflected in the result of the map task
+			if result.element {
+				continue
+			}
 			if result.Error.Valid {
 				errors = append(errors, result.Error)
 			}
@@ -473,6 +535,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		run.FatalErrors = fatalErrors
 		run.Outputs = jsonserializable.JSONSerializable{Val: outputs, Valid: true}
 
+		if capture != nil {
+			capture.record(scheduler.results)
+			run.Capture = capture
+		}
+
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
 			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
@@ -552,7 +619,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if r.fixtures != nil {
+		result, runInfo = r.fixtures.run(ctx, l, taskRun.task, taskRun.vars, taskRun.inputs)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -633,6 +706,9 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 
 	// retain old UUID values
 	for _, taskRun := range run.PipelineTaskRuns {
+		if isMapElementDotID(pipeline, taskRun.DotID) {
+			continue
+		}
 		task := pipeline.ByDotID(taskRun.DotID)
 		if task == nil || task.Base() == nil {
 			return false, pkgerrors.Errorf("failed to match a pipeline task for dot ID: %v", taskRun.DotID)
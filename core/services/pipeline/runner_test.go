@@ -709,6 +709,8 @@ ds5 [type=http method="GET" url="%s" index=2]
 	require.NoError(t, err)
 	require.Len(t, run.PipelineTaskRuns, 9) // 3 tasks are suspended: ds1_parse, ds1_multiply, median. ds1 is present, but contains ErrPending
 	require.Equal(t, true, incomplete)      // still incomplete
+	// the bridge is kept to authenticate the callback
+	assert.Equal(t, null.StringFrom(bt.Name.String()), run.ByDotID("ds1").BridgeName)
 
 	// TODO: test a pending run that's not marked async=true, that is not allowed
 
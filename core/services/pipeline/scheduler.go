@@ -114,6 +114,10 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 func (s *scheduler) reconstructResults() {
 	// if there's results already present on Run, then this is a resumption. Loop over them and fill results table
 	for _, r := range s.run.PipelineTaskRuns {
+		// elements of map tasks are reflected in the result of the map task
+		if isMapElementDotID(s.pipeline, r.DotID) {
+			continue
+		}
 		task := s.pipeline.ByDotID(r.DotID)
 
 		if task == nil {
@@ -0,0 +1,125 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SimulationFixtures are the recorded inputs of a simulated pipeline run.
+type SimulationFixtures struct {
+	// Vars are the variables the run is started with, e.g. jobRun.requestBody for webhook jobs
+	Vars map[string]interface{} `json:"vars,omitempty"`
+	// Tasks are the recorded results of tasks by DOT ID
+	Tasks map[string]SimulationFixture `json:"tasks,omitempty"`
+}
+
+// SimulationFixture is the recorded result of a single task. For `http` and
+// `bridge` tasks Value is the response body, and non-string values are
+// encoded as JSON. For `ethcall` tasks Value is the hex encoded return data.
+type SimulationFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// simulatedTaskTypes are the tasks which reach out to the network, chains or
+// keys, and hence must have a fixture to be simulated.
+var simulatedTaskTypes = map[TaskType]struct{}{
+	TaskTypeHTTP:             {},
+	TaskTypeBridge:           {},
+	TaskTypeETHCall:          {},
+	TaskTypeETHTx:            {},
+	TaskTypeEstimateGasLimit: {},
+	TaskTypeVRF:              {},
+	TaskTypeVRFV2:            {},
+	TaskTypeVRFV2Plus:        {},
+}
+
+// ParseSimulationFixtures parses fixtures from JSON. Empty input results in no fixtures.
+func ParseSimulationFixtures(b []byte) (fixtures SimulationFixtures, err error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	d.DisallowUnknownFields()
+	if err = d.Decode(&fixtures); err != nil {
+		return fixtures, errors.Wrap(err, "failed to parse fixtures")
+	}
+	// Numbers are decoded the same way as JSON in task params
+	for k, v := range fixtures.Vars {
+		if fixtures.Vars[k], err = jsonserializable.ReinterpretJSONNumbers(v); err != nil {
+			return fixtures, errors.Wrapf(err, "failed to parse fixtures: var %q", k)
+		}
+	}
+	for dotID, fixture := range fixtures.Tasks {
+		if fixture.Value, err = jsonserializable.ReinterpretJSONNumbers(fixture.Value); err != nil {
+			return fixtures, errors.Wrapf(err, "failed to parse fixtures: task %q", dotID)
+		}
+		fixtures.Tasks[dotID] = fixture
+	}
+	return
+}
+
+// Simulate executes the pipeline of spec in-memory, without a database or
+// any network access. Tasks which would reach out to the network, chains or
+// keys return their result from fixtures instead, and fail if there is none.
+// Any other task may be overridden by a fixture as well.
+func Simulate(ctx context.Context, cfg Config, lggr logger.Logger, spec Spec, fixtures SimulationFixtures) (*Run, error) {
+	r := &runner{
+		config:      cfg,
+		lggr:        lggr.Named("PipelineSimulator"),
+		chStop:      make(chan struct{}),
+		runFinished: func(*Run) {},
+		fixtures:    &fixtures,
+	}
+	spec.Pipeline = nil
+	run, _, err := r.ExecuteRun(ctx, spec, NewVarsFrom(fixtures.Vars))
+	return run, err
+}
+
+// run returns the result of task from its fixture, or runs the task if it has none and is not simulated.
+func (f *SimulationFixtures) run(ctx context.Context, lggr logger.Logger, task Task, vars Vars, inputs []Result) (Result, RunInfo) {
+	fixture, ok := f.Tasks[task.DotID()]
+	if !ok {
+		if _, simulated := simulatedTaskTypes[task.Type()]; simulated {
+			return Result{Error: errors.Errorf("no fixture for %s task %q", task.Type(), task.DotID())}, RunInfo{}
+		}
+		return task.Run(ctx, lggr, vars, inputs)
+	}
+	if fixture.Error != "" {
+		return Result{Error: errors.New(fixture.Error)}, RunInfo{}
+	}
+
+	value := fixture.Value
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge:
+		// Responses are always stringified
+		if _, isString := value.(string); !isString {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return Result{Error: errors.Wrapf(err, "invalid fixture for %s task %q", task.Type(), task.DotID())}, RunInfo{}
+			}
+			value = string(b)
+		}
+	case TaskTypeETHCall:
+		s, isString := value.(string)
+		if !isString {
+			return Result{Error: errors.Errorf("invalid fixture for %s task %q: expected hex encoded string, got %T", task.Type(), task.DotID(), value)}, RunInfo{}
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return Result{Error: errors.Wrapf(err, "invalid fixture for %s task %q", task.Type(), task.DotID())}, RunInfo{}
+		}
+		value = b
+	default:
+	}
+	return Result{Value: value}, RunInfo{}
+}
@@ -0,0 +1,109 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	spec := pipeline.Spec{
+		DotDagSource: `
+			ds1          [type=http method=GET url="https://example.com/price"];
+			ds1_parse    [type=jsonparse path="data,price"];
+			ds2          [type=bridge name=price_adapter requestData=<{"asset": $(asset)}>];
+			ds2_parse    [type=jsonparse path="result"];
+			call         [type=ethcall contract="0x0000000000000000000000000000000000000001" data="0x"];
+			call_decode  [type=ethabidecode abi="uint256 answer" data="$(call)"];
+			ds1 -> ds1_parse -> median;
+			ds2 -> ds2_parse -> median;
+			call -> call_decode;
+			median       [type=median];
+			multiply     [type=multiply input="$(median)" times="$(call_decode.answer)"];
+		`,
+	}
+
+	fixtures, err := pipeline.ParseSimulationFixtures([]byte(`{
+		"vars": { "asset": "ETH" },
+		"tasks": {
+			"ds1": { "value": "{\"data\":{\"price\":100}}" },
+			"ds2": { "value": { "result": 200 } },
+			"call": { "value": "0x000000000000000000000000000000000000000000000000000000000000000a" }
+		}
+	}`))
+	require.NoError(t, err)
+
+	t.Run("runs the pipeline with fixtures", func(t *testing.T) {
+		run, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), logger.TestLogger(t), spec, fixtures)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		outputs, err := run.StringOutputs()
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		assert.Equal(t, "1500", *outputs[0])
+		require.Len(t, run.PipelineTaskRuns, 8)
+		for _, tr := range run.PipelineTaskRuns {
+			assert.False(t, tr.Error.Valid, "task %s errored: %s", tr.DotID, tr.Error.String)
+			assert.True(t, tr.FinishedAt.Valid)
+		}
+	})
+
+	t.Run("fails tasks without fixtures", func(t *testing.T) {
+		missing := pipeline.SimulationFixtures{Vars: fixtures.Vars, Tasks: map[string]pipeline.SimulationFixture{
+			"ds1":  fixtures.Tasks["ds1"],
+			"call": fixtures.Tasks["call"],
+		}}
+		run, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), logger.TestLogger(t), spec, missing)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		ds2 := run.ByDotID("ds2")
+		require.NotNil(t, ds2)
+		assert.Equal(t, `no fixture for bridge task "ds2"`, ds2.Error.String)
+		ds1 := run.ByDotID("ds1")
+		require.NotNil(t, ds1)
+		assert.False(t, ds1.Error.Valid)
+	})
+
+	t.Run("returns errors from fixtures", func(t *testing.T) {
+		failing := pipeline.SimulationFixtures{Vars: fixtures.Vars, Tasks: map[string]pipeline.SimulationFixture{
+			"ds1":  {Error: "connection refused"},
+			"ds2":  {Error: "connection refused"},
+			"call": fixtures.Tasks["call"],
+		}}
+		run, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), logger.TestLogger(t), spec, failing)
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		assert.True(t, run.HasFatalErrors())
+		for _, dotID := range []string{"ds1", "ds2"} {
+			tr := run.ByDotID(dotID)
+			require.NotNil(t, tr, dotID)
+			assert.Equal(t, "connection refused", tr.Error.String)
+		}
+	})
+
+	t.Run("rejects invalid fixtures", func(t *testing.T) {
+		_, err := pipeline.ParseSimulationFixtures([]byte(`{"task": {}}`))
+		require.ErrorContains(t, err, `unknown field "task"`)
+
+		invalid := pipeline.SimulationFixtures{Tasks: map[string]pipeline.SimulationFixture{
+			"call": {Value: 1},
+		}}
+		run, err := pipeline.Simulate(testutils.Context(t), cfg.JobPipeline(), logger.TestLogger(t), spec, invalid)
+		require.NoError(t, err)
+		call := run.ByDotID("call")
+		require.NotNil(t, call)
+		assert.Equal(t, `invalid fixture for ethcall task "call": expected hex encoded string, got int`, call.Error.String)
+	})
+}
@@ -0,0 +1,336 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	AggregateMethodMedian         = "median"
+	AggregateMethodMean           = "mean"
+	AggregateMethodTrimmedMean    = "trimmedmean"
+	AggregateMethodWeightedMedian = "weightedmedian"
+
+	AggregateFilterNone = "none"
+	AggregateFilterMAD  = "mad"
+	AggregateFilterIQR  = "iqr"
+)
+
+var (
+	// madScale makes the median absolute deviation a consistent estimator of
+	// the standard deviation of normally distributed values.
+	madScale = decimal.RequireFromString("1.4826")
+
+	defaultMADThreshold = decimal.NewFromInt(3)
+	defaultIQRThreshold = decimal.RequireFromString("1.5")
+	defaultTrim         = decimal.RequireFromString("0.1")
+)
+
+// AggregateTask discards outliers among values and aggregates the rest.
+//
+// Outliers are values which deviate from the median by more than threshold
+// (default 3) times the scaled median absolute deviation with filter=mad, or
+// which lie more than threshold (default 1.5) times the interquartile range
+// outside of the quartiles with filter=iqr. No values are discarded with
+// filter=none, the default.
+//
+// The remaining values are aggregated with method=median (the default),
+// mean, trimmedmean, which drops the trim (default 0.1) fraction of the
+// lowest and highest values first, or weightedmedian. The weights of the
+// weighted median, e.g. volumes, are either given as weights, or each value
+// is a [value, weight] pair.
+//
+// Return types:
+//
+//	map[string]interface{}{
+//	    "value": decimal.Decimal
+//	    "rejected": []interface{} of map[string]interface{}{"index": int, "value": decimal.Decimal}
+//	}
+type AggregateTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+	Method        string `json:"method"`
+	Filter        string `json:"filter"`
+	Threshold     string `json:"threshold"`
+	Trim          string `json:"trim"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*AggregateTask)(nil)
+
+func (t *AggregateTask) Type() TaskType {
+	return TaskTypeAggregate
+}
+
+// aggregateValue is a value to aggregate, along with its position among the inputs.
+type aggregateValue struct {
+	index  int
+	value  decimal.Decimal
+	weight decimal.Decimal
+}
+
+func (t *AggregateTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		method             StringParam
+		filter             StringParam
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		allowedFaults      int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), AggregateMethodMedian)), "method"),
+		errors.Wrap(ResolveParam(&filter, From(NonemptyString(t.Filter), AggregateFilterNone)), "filter"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false), nil)), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var defaultThreshold decimal.Decimal
+	switch filter {
+	case AggregateFilterNone:
+	case AggregateFilterMAD:
+		defaultThreshold = defaultMADThreshold
+	case AggregateFilterIQR:
+		defaultThreshold = defaultIQRThreshold
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "filter: unknown filter %q", filter)}, runInfo
+	}
+	var threshold, trim DecimalParam
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultThreshold)), "threshold"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim), defaultTrim)), "trim"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if threshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrap(ErrBadInput, "threshold must not be negative")}, runInfo
+	}
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.RequireFromString("0.5")) {
+		return Result{Error: errors.Wrap(ErrBadInput, "trim must be at least 0 and less than 0.5")}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	values, err := t.parseValues(valuesAndErrs, weights, string(method))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if faults := len(valuesAndErrs) - len(values); faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to aggregate task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(values) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to aggregate")}, runInfo
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value.LessThan(values[j].value)
+	})
+
+	var accepted, rejected []aggregateValue
+	switch filter {
+	case AggregateFilterMAD:
+		accepted, rejected = filterMAD(values, threshold.Decimal())
+	case AggregateFilterIQR:
+		accepted, rejected = filterIQR(values, threshold.Decimal())
+	default:
+		accepted = values
+	}
+	if len(accepted) == 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "filter: %s filter with threshold %s rejected all values", filter, threshold.Decimal())}, runInfo
+	}
+
+	var value decimal.Decimal
+	switch method {
+	case AggregateMethodMedian:
+		value = sortedMedian(accepted)
+	case AggregateMethodMean:
+		value = mean(accepted, maybePrecision)
+	case AggregateMethodTrimmedMean:
+		k := int(trim.Decimal().Mul(decimal.NewFromInt(int64(len(accepted)))).IntPart())
+		value = mean(accepted[k:len(accepted)-k], maybePrecision)
+	case AggregateMethodWeightedMedian:
+		value, err = weightedMedian(accepted)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i].index < rejected[j].index
+	})
+	rejectedValues := make([]interface{}, len(rejected))
+	for i, r := range rejected {
+		rejectedValues[i] = map[string]interface{}{"index": r.index, "value": r.value}
+	}
+	return Result{Value: map[string]interface{}{
+		"value":    value,
+		"rejected": rejectedValues,
+	}}, runInfo
+}
+
+// parseValues returns the values which are not errors, along with their weights for weighted medians.
+func (t *AggregateTask) parseValues(valuesAndErrs SliceParam, weights DecimalSliceParam, method string) ([]aggregateValue, error) {
+	switch method {
+	case AggregateMethodMedian, AggregateMethodMean, AggregateMethodTrimmedMean:
+	case AggregateMethodWeightedMedian:
+		if weights != nil && len(weights) != len(valuesAndErrs) {
+			return nil, errors.Wrapf(ErrBadInput, "weights: expected %d weights, got %d", len(valuesAndErrs), len(weights))
+		}
+	default:
+		return nil, errors.Wrapf(ErrBadInput, "method: unknown method %q", method)
+	}
+
+	var values []aggregateValue
+	for i, v := range valuesAndErrs {
+		if _, isErr := v.(error); isErr {
+			continue
+		}
+		av := aggregateValue{index: i, weight: decimal.NewFromInt(1)}
+		if method == AggregateMethodWeightedMedian && weights == nil {
+			var pair DecimalSliceParam
+			if err := pair.UnmarshalPipelineParam(v); err != nil || len(pair) != 2 {
+				return nil, errors.Wrapf(ErrBadInput, "values: expected a [value, weight] pair at index %d", i)
+			}
+			av.value, av.weight = pair[0], pair[1]
+		} else {
+			var d DecimalParam
+			if err := d.UnmarshalPipelineParam(v); err != nil {
+				return nil, errors.Wrapf(ErrBadInput, "values: %v", err)
+			}
+			av.value = d.Decimal()
+			if weights != nil {
+				av.weight = weights[i]
+			}
+		}
+		if av.weight.IsNegative() {
+			return nil, errors.Wrapf(ErrBadInput, "weights: negative weight at index %d", i)
+		}
+		values = append(values, av)
+	}
+	return values, nil
+}
+
+// filterMAD splits sorted values into those within threshold scaled median
+// absolute deviations from the median, and the rest. Nothing is rejected if
+// most values are equal, and hence the deviation is zero.
+func filterMAD(values []aggregateValue, threshold decimal.Decimal) (accepted, rejected []aggregateValue) {
+	median := sortedMedian(values)
+	deviations := make([]aggregateValue, len(values))
+	for i, v := range values {
+		deviations[i] = aggregateValue{value: v.value.Sub(median).Abs()}
+	}
+	sort.Slice(deviations, func(i, j int) bool {
+		return deviations[i].value.LessThan(deviations[j].value)
+	})
+	mad := sortedMedian(deviations).Mul(madScale)
+	if mad.IsZero() {
+		return values, nil
+	}
+	limit := mad.Mul(threshold)
+	for _, v := range values {
+		if v.value.Sub(median).Abs().GreaterThan(limit) {
+			rejected = append(rejected, v)
+		} else {
+			accepted = append(accepted, v)
+		}
+	}
+	return accepted, rejected
+}
+
+// filterIQR splits sorted values into those within threshold interquartile
+// ranges below the first or above the third quartile, and the rest.
+func filterIQR(values []aggregateValue, threshold decimal.Decimal) (accepted, rejected []aggregateValue) {
+	q1 := quantile(values, decimal.RequireFromString("0.25"))
+	q3 := quantile(values, decimal.RequireFromString("0.75"))
+	margin := q3.Sub(q1).Mul(threshold)
+	lower, upper := q1.Sub(margin), q3.Add(margin)
+	for _, v := range values {
+		if v.value.LessThan(lower) || v.value.GreaterThan(upper) {
+			rejected = append(rejected, v)
+		} else {
+			accepted = append(accepted, v)
+		}
+	}
+	return accepted, rejected
+}
+
+// quantile interpolates the q quantile of sorted, non-empty values linearly.
+func quantile(values []aggregateValue, q decimal.Decimal) decimal.Decimal {
+	pos := q.Mul(decimal.NewFromInt(int64(len(values) - 1)))
+	k := int(pos.IntPart())
+	if k+1 >= len(values) {
+		return values[len(values)-1].value
+	}
+	frac := pos.Sub(decimal.NewFromInt(int64(k)))
+	return values[k].value.Add(values[k+1].value.Sub(values[k].value).Mul(frac))
+}
+
+// sortedMedian returns the median of sorted, non-empty values.
+func sortedMedian(values []aggregateValue) decimal.Decimal {
+	k := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[k].value
+	}
+	return values[k].value.Add(values[k-1].value).Div(decimal.NewFromInt(2))
+}
+
+func mean(values []aggregateValue, maybePrecision MaybeInt32Param) decimal.Decimal {
+	total := decimal.NewFromInt(0)
+	for _, v := range values {
+		total = total.Add(v.value)
+	}
+	numValues := decimal.NewFromInt(int64(len(values)))
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		return total.DivRound(numValues, precision)
+	}
+	return total.Div(numValues)
+}
+
+// weightedMedian returns the value of sorted values at which half of the
+// total weight is reached, averaging the two values around it if it falls
+// exactly between them.
+func weightedMedian(values []aggregateValue) (decimal.Decimal, error) {
+	total := decimal.NewFromInt(0)
+	for _, v := range values {
+		total = total.Add(v.weight)
+	}
+	if !total.IsPositive() {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, "weights: total weight must be positive")
+	}
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.NewFromInt(0)
+	for i, v := range values {
+		cumulative = cumulative.Add(v.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			for _, next := range values[i+1:] {
+				// skip values without weight, which do not move the median
+				if next.weight.IsPositive() {
+					return v.value.Add(next.value).Div(decimal.NewFromInt(2)), nil
+				}
+			}
+		}
+		return v.value, nil
+	}
+	return values[len(values)-1].value, nil
+}
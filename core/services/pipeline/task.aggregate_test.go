@@ -0,0 +1,260 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestAggregateTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		task     pipeline.AggregateTask
+		inputs   []interface{}
+		want     string
+		rejected []int
+		err      error
+	}{
+		{
+			"median by default",
+			pipeline.AggregateTask{},
+			[]interface{}{"1", "2", "3", "100"},
+			"2.5",
+			nil,
+			nil,
+		},
+		{
+			"mad filter rejects outliers",
+			pipeline.AggregateTask{Filter: "mad"},
+			[]interface{}{"100", "101", "99", "100.5", "150", "10"},
+			"100.25",
+			[]int{4, 5},
+			nil,
+		},
+		{
+			"mad filter with lower threshold",
+			pipeline.AggregateTask{Filter: "mad", Threshold: "1"},
+			[]interface{}{"100", "101", "99", "100.5", "150"},
+			"100.5",
+			[]int{2, 4},
+			nil,
+		},
+		{
+			"mad filter keeps everything when most values are equal",
+			pipeline.AggregateTask{Filter: "mad", Method: "mean"},
+			[]interface{}{"5", "5", "5", "8"},
+			"5.75",
+			nil,
+			nil,
+		},
+		{
+			"iqr filter with mean",
+			pipeline.AggregateTask{Filter: "iqr", Method: "mean"},
+			[]interface{}{"10", "11", "12", "13", "14", "50"},
+			"12",
+			[]int{5},
+			nil,
+		},
+		{
+			"mad filter rejects all values",
+			pipeline.AggregateTask{Filter: "mad", Threshold: "0.1"},
+			[]interface{}{"1", "2", "3", "4"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"iqr filter rejects all values",
+			pipeline.AggregateTask{Filter: "iqr", Threshold: "0"},
+			[]interface{}{"1", "4"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"trimmed mean",
+			pipeline.AggregateTask{Method: "trimmedmean", Trim: "0.2"},
+			[]interface{}{"1", "10", "11", "12", "1000"},
+			"11",
+			nil,
+			nil,
+		},
+		{
+			"trimmed mean with precision",
+			pipeline.AggregateTask{Method: "trimmedmean", Trim: "0.1", Precision: "2"},
+			[]interface{}{"1", "2", "2"},
+			"1.67",
+			nil,
+			nil,
+		},
+		{
+			"weighted median with weights",
+			pipeline.AggregateTask{Method: "weightedmedian", Weights: `[1, 1, 10]`},
+			[]interface{}{"1", "2", "3"},
+			"3",
+			nil,
+			nil,
+		},
+		{
+			"weighted median of pairs",
+			pipeline.AggregateTask{Method: "weightedmedian"},
+			[]interface{}{[]interface{}{"1", "5"}, []interface{}{"2", "2"}, []interface{}{"3", "3"}},
+			"1.5",
+			nil,
+			nil,
+		},
+		{
+			"weighted median with mad filter",
+			pipeline.AggregateTask{Method: "weightedmedian", Filter: "mad"},
+			[]interface{}{[]interface{}{"100", "1"}, []interface{}{"101", "3"}, []interface{}{"99", "1"}, []interface{}{"500", "100"}},
+			"101",
+			[]int{3},
+			nil,
+		},
+		{
+			"faulty inputs within allowed faults",
+			pipeline.AggregateTask{Filter: "mad", AllowedFaults: "1"},
+			[]interface{}{"1", errors.New("foo"), "2", "3"},
+			"2",
+			nil,
+			nil,
+		},
+		{
+			"too many faulty inputs",
+			pipeline.AggregateTask{AllowedFaults: "1"},
+			[]interface{}{errors.New("foo"), errors.New("bar"), "3"},
+			"",
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"no inputs",
+			pipeline.AggregateTask{AllowedFaults: "0"},
+			[]interface{}{},
+			"",
+			nil,
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"unknown method",
+			pipeline.AggregateTask{Method: "foo"},
+			[]interface{}{"1"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"unknown filter",
+			pipeline.AggregateTask{Filter: "foo"},
+			[]interface{}{"1"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"trim too large",
+			pipeline.AggregateTask{Method: "trimmedmean", Trim: "0.5"},
+			[]interface{}{"1"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"weights do not match values",
+			pipeline.AggregateTask{Method: "weightedmedian", Weights: `[1, 2]`},
+			[]interface{}{"1"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"value is not a pair",
+			pipeline.AggregateTask{Method: "weightedmedian"},
+			[]interface{}{"1"},
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var inputs []pipeline.Result
+			for _, input := range test.inputs {
+				if err, isErr := input.(error); isErr {
+					inputs = append(inputs, pipeline.Result{Error: err})
+				} else {
+					inputs = append(inputs, pipeline.Result{Value: input})
+				}
+			}
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": test.inputs}})
+
+			for name, values := range map[string]string{"inputs": "", "vars": "$(foo.bar)"} {
+				task := test.task
+				task.BaseTask = pipeline.NewBaseTask(0, "task", nil, nil, 0)
+				task.Values = values
+				var taskInputs []pipeline.Result
+				if values == "" {
+					taskInputs = inputs
+				}
+				output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, taskInputs)
+				assert.False(t, runInfo.IsPending, name)
+				assert.False(t, runInfo.IsRetryable, name)
+				if test.err != nil {
+					require.Equal(t, test.err, errors.Cause(output.Error), name)
+					require.Nil(t, output.Value, name)
+					continue
+				}
+				require.NoError(t, output.Error, name)
+				result := output.Value.(map[string]interface{})
+				assert.Equal(t, test.want, result["value"].(decimal.Decimal).String(), name)
+				var rejected []int
+				for _, r := range result["rejected"].([]interface{}) {
+					rejected = append(rejected, r.(map[string]interface{})["index"].(int))
+				}
+				assert.Equal(t, test.rejected, rejected, name)
+			}
+		})
+	}
+}
+
+func TestAggregateTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+		agg      [type=aggregate filter=mad values=<[ $(a), $(b), $(c), $(d) ]>];
+		multiply [type=multiply input="$(agg.value)" times=100];
+		agg -> multiply;
+	`}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"a": 1.01, "b": 1.02, "c": 1.03, "d": 7})
+	run, _, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+	require.NoError(t, err)
+	require.False(t, run.HasErrors())
+
+	outputs, err := run.StringOutputs()
+	require.NoError(t, err)
+	assert.Equal(t, "102", *outputs[0])
+
+	// the rejected inputs are recorded in the task run of the aggregate task
+	tr := run.ByDotID("agg")
+	require.NotNil(t, tr)
+	b, err := tr.Output.MarshalJSON()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value": "1.02", "rejected": [{"index": 3, "value": "7"}]}`, string(b))
+}
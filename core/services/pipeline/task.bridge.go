@@ -69,6 +69,9 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+	httpGovernor *HTTPGovernor
+	bridgeAuth   *bridges.Authenticator
+	bridgeHealth *bridges.HealthChecker
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -112,10 +115,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.getBridgeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	endpoints := t.bridgeHealth.Endpoints(bt)
+	url := URLParam(endpoints[0])
 
 	var metaMap MapParam
 
@@ -159,6 +164,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
+	client, reqHeaders, err := t.authenticate(bt, requestDataJSON, reqHeaders)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
@@ -169,8 +179,25 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		elapsed        time.Duration
+	)
+	for i, endpoint := range endpoints {
+		url = URLParam(endpoint)
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, client, t.httpGovernor, t.config.DefaultHTTPLimit())
+		if i == len(endpoints)-1 || requestCtx.Err() != nil || !shouldFailover(statusCode, err) {
+			break
+		}
+		lggr.Warnw("Bridge task: request failed, trying next endpoint",
+			"err", err,
+			"url", url.String(),
+			"next", endpoints[i+1].String(),
+		)
+	}
 
 	// check for external adapter response object status
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
@@ -210,16 +237,21 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	if t.Async == "true" {
+		// The resolved bridge name is kept with the pending task run, so the callback resuming it can be authenticated
+		// even if the name is only known at run time
+		pending := pendingRunInfo()
+		pending.bridgeName = bt.Name.String()
+
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
 		if _, ok := headers["X-Chainlink-Pending"]; ok {
-			return result, pendingRunInfo()
+			return result, pending
 		}
 
 		var response struct {
 			Pending bool `json:"pending"`
 		}
 		if err := json.Unmarshal(responseBytes, &response); err == nil && response.Pending {
-			return Result{}, pendingRunInfo()
+			return Result{}, pending
 		}
 	}
 
@@ -248,12 +280,45 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+func (t *BridgeTask) getBridgeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
+}
+
+// authenticate applies the auth scheme of the bridge, returning the client
+// and headers for the request with body.
+func (t *BridgeTask) authenticate(bt bridges.BridgeType, body []byte, reqHeaders []string) (*http.Client, []string, error) {
+	if bt.AuthScheme == "" || bt.AuthScheme == bridges.AuthSchemeNone {
+		return t.httpClient, reqHeaders, nil
+	}
+	if t.bridgeAuth == nil {
+		return nil, nil, errors.Errorf("bridge %q requires %s auth, which is not available", bt.Name, bt.AuthScheme)
+	}
+	switch bt.AuthScheme {
+	case bridges.AuthSchemeHMAC:
+		auth, err := t.bridgeAuth.Credentials(bt)
+		if err != nil {
+			return nil, nil, err
+		}
+		// makeHTTPRequest encodes the same request data to the same body
+		timestamp, signature := bridges.SignatureHeaders(auth.HMACSecret, body, time.Now())
+		headers := append(append([]string{}, reqHeaders...), bridges.HeaderTimestamp, timestamp, bridges.HeaderSignature, signature)
+		return t.httpClient, headers, nil
+	case bridges.AuthSchemeMTLS:
+		client, err := t.bridgeAuth.Client(bt, t.httpClient)
+		return client, reqHeaders, err
+	default:
+		return nil, nil, errors.Errorf("bridge %q has unknown auth scheme %q", bt.Name, bt.AuthScheme)
+	}
+}
+
+// shouldFailover reports whether a request failed in a way that another
+// endpoint of the bridge may not, i.e. it got no response or a server error.
+func shouldFailover(statusCode int, err error) bool {
+	return err != nil && (statusCode == 0 || statusCode >= http.StatusInternalServerError)
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -19,12 +19,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -1280,3 +1282,144 @@ ds [type=bridge name="adapter-error-bridge" timeout="50ms" requestData="{\"data\
 	require.ErrorContains(t, finalResult.Result.Error, "AdapterLWBAError: bid ask violation detected")
 	require.Nil(t, finalResult.Result.Value)
 }
+
+func TestBridgeTask_Auth(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	const password = "p4SsW0rD1!@#_"
+
+	var (
+		body    []byte
+		headers http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		headers = r.Header
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write([]byte(`{"fooresponse": 1}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	btr := &bridges.BridgeTypeRequest{
+		Name:       bridges.MustParseBridgeName("signed"),
+		URL:        models.WebURL(*u),
+		AuthScheme: bridges.AuthSchemeHMAC,
+		Auth:       &bridges.BridgeAuth{HMACSecret: "secret"},
+	}
+	_, bt, err := bridges.NewBridgeType(btr)
+	require.NoError(t, err)
+	require.NoError(t, bt.SetAuth(btr, nil, password, utils.FastScryptParams))
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil)
+
+	newTask := func(authenticator *bridges.Authenticator) pipeline.BridgeTask {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        bt.Name.String(),
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		task.HelperSetBridgeAuthenticator(authenticator)
+		return task
+	}
+
+	t.Run("signs requests", func(t *testing.T) {
+		task := newTask(bridges.NewAuthenticator(password))
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"fooresponse": 1}`, result.Value)
+		assert.NoError(t, bridges.VerifySignature("secret", headers, body, time.Now(), bridges.DefaultSignatureMaxSkew))
+	})
+
+	t.Run("errors without authenticator", func(t *testing.T) {
+		task := newTask(nil)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "requires hmac auth")
+	})
+}
+
+func TestBridgeTask_Failover(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	var failingRequests atomic.Int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failingRequests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(`{"error": "bad request"}`))
+		require.NoError(t, err)
+	}))
+	defer rejecting.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"fooresponse": 1}`))
+		require.NoError(t, err)
+	}))
+	defer healthy.Close()
+
+	newBridge := func(name string, urls ...string) bridges.BridgeType {
+		bt := bridges.BridgeType{Name: bridges.MustParseBridgeName(name)}
+		for i, s := range urls {
+			u, err := url.Parse(s)
+			require.NoError(t, err)
+			if i == 0 {
+				bt.URL = models.WebURL(*u)
+			} else {
+				bt.FallbackURLs = append(bt.FallbackURLs, models.WebURL(*u))
+			}
+		}
+		return bt
+	}
+	orm := bridgesMocks.NewORM(t)
+	for _, bt := range []bridges.BridgeType{
+		newBridge("failover", failing.URL, healthy.URL),
+		newBridge("rejected", rejecting.URL, healthy.URL),
+		newBridge("down", failing.URL, failing.URL),
+	} {
+		orm.On("FindBridge", mock.Anything, bt.Name).Return(bt, nil).Maybe()
+	}
+
+	run := func(name string) pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        name,
+			RequestData: btcUSDPairing,
+		}
+		task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("tries the next endpoint on server errors", func(t *testing.T) {
+		result := run("failover")
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"fooresponse": 1}`, result.Value)
+	})
+
+	t.Run("does not try the next endpoint on client errors", func(t *testing.T) {
+		result := run("rejected")
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "bad request")
+	})
+
+	t.Run("fails if all endpoints fail", func(t *testing.T) {
+		before := failingRequests.Load()
+		result := run("down")
+		require.Error(t, result.Error)
+		assert.Equal(t, before+2, failingRequests.Load())
+	})
+}
@@ -0,0 +1,363 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ETHABIEncodeJSONTask ABI encodes values taken from a JSON document, e.g. an HTTP response, without having to extract
+// every value with a separate jsonparse task.
+//
+// The ABI uses the same format as ethabiencode2 and may contain tuples and arrays of tuples. The mapping is a JSON
+// object with an entry per ABI argument. Each entry is either a path to the value in the JSON document, or an object:
+//
+//	{"path": "<path>", "fields": {"<tuple field>": <entry>, ...}, "items": <entry>}
+//
+// Paths are "."-separated object keys and array indexes and are relative to the enclosing entry's value. An empty path
+// selects the enclosing value itself. "fields" applies to tuples, and to every element of an array of tuples; tuple
+// fields without an entry are taken from the JSON key with the same name. "items" applies to every element of an array.
+//
+// Values are validated against their ABI types and errors name both the argument and the JSON path at fault.
+//
+// Return types:
+//
+//	string
+type ETHABIEncodeJSONTask struct {
+	BaseTask `mapstructure:",squash"`
+	ABI      string `json:"abi"`
+	Data     string `json:"data"`
+	Mapping  string `json:"mapping"`
+}
+
+var _ Task = (*ETHABIEncodeJSONTask)(nil)
+
+func (t *ETHABIEncodeJSONTask) Type() TaskType {
+	return TaskTypeETHABIEncodeJSON
+}
+
+func (t *ETHABIEncodeJSONTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (Result, RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+
+	var (
+		document jsonDocumentParam
+		mapping  MapParam
+		theABI   BytesParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&document, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&mapping, From(VarExpr(t.Mapping, vars), NonemptyString(t.Mapping))), "mapping"),
+		errors.Wrap(ResolveParam(&theABI, From(NonemptyString(t.ABI))), "abi"),
+	)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	inputMethod := Method{}
+	err = json.Unmarshal(theABI, &inputMethod)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "ETHABIEncodeJSON: while parsing ABI string: %v", err)}, RunInfo{}
+	}
+
+	method := abi.NewMethod(inputMethod.Name, inputMethod.Name, abi.Function, "", false, false, inputMethod.Inputs, nil)
+
+	var vals []interface{}
+	for _, arg := range method.Inputs {
+		if len(arg.Name) == 0 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "ETHABIEncodeJSON: bad ABI specification, missing argument name")}, RunInfo{}
+		}
+		argMapping, exists := mapping[arg.Name]
+		if !exists {
+			return Result{Error: errors.Wrapf(ErrBadInput, "ETHABIEncodeJSON: mapping for argument '%v' is missing", arg.Name)}, RunInfo{}
+		}
+		val, err := mapJSONToETHABIType(document.value, "$", argMapping, arg.Type, arg.Name)
+		if err != nil {
+			return Result{Error: errors.Wrap(ErrBadInput, "ETHABIEncodeJSON: "+err.Error())}, RunInfo{}
+		}
+		vals = append(vals, val)
+	}
+
+	argsEncoded, err := method.Inputs.Pack(vals...)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "ETHABIEncodeJSON: could not ABI encode values: %v", err)}, RunInfo{}
+	}
+	var dataBytes []byte
+	if method.Name != "" {
+		dataBytes = append(method.ID, argsEncoded...)
+	} else {
+		dataBytes = argsEncoded
+	}
+	return Result{Value: hexutil.Encode(dataBytes)}, RunInfo{}
+}
+
+// jsonDocumentParam accepts a JSON encoded string or bytes, or an already decoded JSON object or array.
+// Numbers are kept as json.Number to not lose precision.
+type jsonDocumentParam struct {
+	value interface{}
+}
+
+func (p *jsonDocumentParam) UnmarshalPipelineParam(val interface{}) error {
+	switch v := val.(type) {
+	case string:
+		return p.UnmarshalPipelineParam([]byte(v))
+	case []byte:
+		d := json.NewDecoder(bytes.NewReader(v))
+		d.UseNumber()
+		return d.Decode(&p.value)
+	case map[string]interface{}, []interface{}:
+		p.value = v
+		return nil
+	case MapParam:
+		p.value = map[string]interface{}(v)
+		return nil
+	case SliceParam:
+		p.value = []interface{}(v)
+		return nil
+	}
+	return errors.Wrapf(ErrBadInput, "expected JSON document, got %T", val)
+}
+
+// jsonABIMapping is a parsed entry of the mapping
+type jsonABIMapping struct {
+	path   string
+	fields map[string]interface{}
+	items  interface{}
+}
+
+func parseJSONABIMapping(mapping interface{}) (m jsonABIMapping, err error) {
+	switch v := mapping.(type) {
+	case string:
+		m.path = v
+	case map[string]interface{}:
+		for key, val := range v {
+			switch key {
+			case "path":
+				path, ok := val.(string)
+				if !ok {
+					return m, errors.Errorf("path must be a string, got %T", val)
+				}
+				m.path = path
+			case "fields":
+				fields, ok := val.(map[string]interface{})
+				if !ok {
+					return m, errors.Errorf("fields must be an object, got %T", val)
+				}
+				m.fields = fields
+			case "items":
+				m.items = val
+			default:
+				return m, errors.Errorf("unknown mapping key %q, expected path, fields or items", key)
+			}
+		}
+	default:
+		return m, errors.Errorf("mapping must be a path string or an object, got %T", mapping)
+	}
+	return m, nil
+}
+
+// mapJSONToETHABIType selects the value for abiType out of node as described by the mapping and converts it.
+// jsonPath is the location of node in the document and argPath the location of the value in the ABI arguments, both
+// are used for error messages.
+func mapJSONToETHABIType(node interface{}, jsonPath string, mapping interface{}, abiType abi.Type, argPath string) (interface{}, error) {
+	m, err := parseJSONABIMapping(mapping)
+	if err != nil {
+		return nil, errors.Errorf("argument %s (%s): %v", argPath, abiType, err)
+	}
+	val, jsonPath, err := resolveJSONPath(node, jsonPath, m.path)
+	if err != nil {
+		return nil, errors.Errorf("argument %s (%s): %v", argPath, abiType, err)
+	}
+	fail := func(format string, args ...interface{}) error {
+		return errors.Errorf("argument %s (%s) at path %q: %s", argPath, abiType, jsonPath, fmt.Sprintf(format, args...))
+	}
+
+	switch abiType.T {
+	case abi.TupleTy:
+		if m.items != nil {
+			return nil, fail("items can only be used with array types")
+		}
+		dest := reflect.New(abiType.TupleType).Elem()
+		for i, name := range abiType.TupleRawNames {
+			fieldMapping, exists := m.fields[name]
+			if !exists {
+				fieldMapping = name
+			}
+			elem, err := mapJSONToETHABIType(val, jsonPath, fieldMapping, *abiType.TupleElems[i], argPath+"."+name)
+			if err != nil {
+				return nil, err
+			}
+			dest.Field(i).Set(reflect.ValueOf(elem))
+		}
+		return dest.Interface(), nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		arr, ok := val.([]interface{})
+		if !ok {
+			return nil, fail("expected array, got %s", jsonTypeName(val))
+		}
+		itemMapping := m.items
+		if itemMapping == nil {
+			if m.fields != nil {
+				itemMapping = map[string]interface{}{"fields": m.fields}
+			} else {
+				itemMapping = ""
+			}
+		} else if m.fields != nil {
+			return nil, fail("fields and items cannot be used together")
+		}
+
+		var dest reflect.Value
+		if abiType.T == abi.ArrayTy {
+			if len(arr) != abiType.Size {
+				return nil, fail("expected %d elements, got %d", abiType.Size, len(arr))
+			}
+			dest = reflect.New(abiType.GetType()).Elem()
+		} else {
+			dest = reflect.MakeSlice(abiType.GetType(), len(arr), len(arr))
+		}
+		for i, item := range arr {
+			elem, err := mapJSONToETHABIType(item, jsonPath+"."+strconv.Itoa(i), itemMapping, *abiType.Elem, fmt.Sprintf("%s[%d]", argPath, i))
+			if err != nil {
+				return nil, err
+			}
+			dest.Index(i).Set(reflect.ValueOf(elem))
+		}
+		return dest.Interface(), nil
+	}
+
+	if m.fields != nil || m.items != nil {
+		return nil, fail("fields and items can only be used with tuple and array types")
+	}
+	converted, err := convertJSONToETHABIValue(val, abiType)
+	if err != nil {
+		return nil, fail("%v", err)
+	}
+	return converted, nil
+}
+
+// convertJSONToETHABIValue converts a JSON value to an elementary ABI type. Numbers are only accepted for integers.
+func convertJSONToETHABIValue(val interface{}, abiType abi.Type) (interface{}, error) {
+	switch val.(type) {
+	case nil:
+		return nil, errors.New("value is null")
+	case map[string]interface{}, []interface{}:
+		return nil, errors.Errorf("expected %s, got %s", abiType, jsonTypeName(val))
+	}
+	if jsonTypeName(val) == "number" && abiType.T != abi.IntTy && abiType.T != abi.UintTy {
+		return nil, errors.Errorf("expected %s, got number", abiType)
+	}
+	if n, ok := val.(json.Number); ok {
+		val = string(n)
+	}
+
+	switch abiType.T {
+	case abi.IntTy, abi.UintTy:
+		if _, ok := val.(bool); ok {
+			return nil, errors.New("expected integer, got boolean")
+		}
+		d, err := utils.ToDecimal(val)
+		if err != nil {
+			return nil, errors.Errorf("invalid integer %v", val)
+		}
+		return convertJSONToETHABIInteger(d, abiType)
+	case abi.AddressTy:
+		s, ok := val.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return nil, errors.Errorf("expected hex encoded address, got %v", val)
+		}
+		return common.HexToAddress(s), nil
+	case abi.BoolTy:
+		if _, ok := val.(bool); !ok {
+			return nil, errors.Errorf("expected boolean, got %s", jsonTypeName(val))
+		}
+	case abi.StringTy, abi.BytesTy, abi.FixedBytesTy:
+		if _, ok := val.(string); !ok {
+			return nil, errors.Errorf("expected string, got %s", jsonTypeName(val))
+		}
+	}
+	return convertToETHABIType(val, abiType)
+}
+
+func convertJSONToETHABIInteger(d decimal.Decimal, abiType abi.Type) (interface{}, error) {
+	if !d.IsInteger() {
+		return nil, errors.Errorf("%s is not an integer", d)
+	}
+	i := d.BigInt()
+	if abiType.T == abi.UintTy {
+		if i.Sign() < 0 {
+			return nil, errors.Errorf("%s is negative", d)
+		}
+		if i.BitLen() > abiType.Size {
+			return nil, errors.Wrapf(ErrOverflow, "%s does not fit into %s", d, abiType)
+		}
+	} else {
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(abiType.Size-1))
+		if i.Cmp(limit) >= 0 || i.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, errors.Wrapf(ErrOverflow, "%s does not fit into %s", d, abiType)
+		}
+	}
+	return convertToETHABIInteger(i, abiType)
+}
+
+// resolveJSONPath returns the value at the "."-separated path relative to node, and its location in the document
+func resolveJSONPath(node interface{}, jsonPath string, path string) (interface{}, string, error) {
+	keypath, err := NewKeypathFromString(path)
+	if err != nil {
+		return nil, jsonPath, errors.Wrapf(err, "invalid path %q", path)
+	}
+	for _, part := range keypath.Parts {
+		switch v := node.(type) {
+		case map[string]interface{}:
+			var exists bool
+			node, exists = v[part]
+			if !exists {
+				return nil, jsonPath, errors.Errorf("key %q not found at path %q", part, jsonPath)
+			}
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, jsonPath, errors.Errorf("index %q out of range for array of length %d at path %q", part, len(v), jsonPath)
+			}
+			node = v[index]
+		default:
+			return nil, jsonPath, errors.Errorf("cannot resolve %q in %s at path %q", part, jsonTypeName(node), jsonPath)
+		}
+		jsonPath += "." + part
+	}
+	return node, jsonPath, nil
+}
+
+func jsonTypeName(val interface{}) string {
+	switch val.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case json.Number, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, *big.Int, decimal.Decimal:
+		return "number"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	}
+	return fmt.Sprintf("%T", val)
+}
@@ -0,0 +1,280 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const ethABIEncodeJSONTestABI = `{
+	"name": "report",
+	"inputs": [
+		{ "name": "round", "type": "uint80" },
+		{
+			"name": "feeds",
+			"type": "tuple[]",
+			"components": [
+				{ "name": "symbol", "type": "string" },
+				{ "name": "price", "type": "int256" },
+				{ "name": "sources", "type": "address[]" }
+			]
+		},
+		{ "name": "window", "type": "uint32[2]" }
+	]
+}`
+
+const ethABIEncodeJSONTestData = `{
+	"data": {
+		"round": "42",
+		"items": [
+			{ "sym": "ETH", "quote": { "usd": 250000000000 }, "sources": ["0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "0x1111111111111111111111111111111111111111"] },
+			{ "sym": "BTC", "quote": { "usd": -1 }, "sources": [] }
+		],
+		"window": [10, 20]
+	}
+}`
+
+const ethABIEncodeJSONTestMapping = `{
+	"round": "data.round",
+	"feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.usd" } },
+	"window": "data.window"
+}`
+
+func ethABIEncodeJSONTestExpected(t *testing.T) string {
+	var method pipeline.Method
+	require.NoError(t, json.Unmarshal([]byte(ethABIEncodeJSONTestABI), &method))
+	type feed struct {
+		Symbol  string
+		Price   *big.Int
+		Sources []common.Address
+	}
+	packed, err := abi.NewMethod(method.Name, method.Name, abi.Function, "", false, false, method.Inputs, nil).Inputs.Pack(
+		big.NewInt(42),
+		[]feed{
+			{Symbol: "ETH", Price: big.NewInt(250000000000), Sources: []common.Address{common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"), common.HexToAddress("0x1111111111111111111111111111111111111111")}},
+			{Symbol: "BTC", Price: big.NewInt(-1), Sources: []common.Address{}},
+		},
+		[2]uint32{10, 20},
+	)
+	require.NoError(t, err)
+	methodID := abi.NewMethod(method.Name, method.Name, abi.Function, "", false, false, method.Inputs, nil).ID
+	return hexutil.Encode(append(methodID, packed...))
+}
+
+func TestETHABIEncodeJSONTask(t *testing.T) {
+	expected := ethABIEncodeJSONTestExpected(t)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(ethABIEncodeJSONTestData), &decoded))
+
+	pairsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{{Name: "a", Type: "bool"}, {Name: "b", Type: "bytes32"}})
+	require.NoError(t, err)
+	matrixType, err := abi.NewType("uint8[][]", "", nil)
+	require.NoError(t, err)
+	itemsPacked, err := abi.Arguments{{Name: "pairs", Type: pairsType}, {Name: "matrix", Type: matrixType}}.Pack(
+		[]struct {
+			A bool
+			B [32]byte
+		}{{A: true, B: [32]byte{31: 1}}},
+		[][]uint8{{1, 2}, {3}},
+	)
+	require.NoError(t, err)
+	itemsExpected := hexutil.Encode(itemsPacked)
+
+	tests := []struct {
+		name                  string
+		abi                   string
+		data                  string
+		mapping               string
+		vars                  pipeline.Vars
+		inputs                []pipeline.Result
+		expected              string
+		expectedErrorCause    error
+		expectedErrorContains string
+	}{
+		{
+			"nested tuples and arrays from input",
+			ethABIEncodeJSONTestABI,
+			"",
+			ethABIEncodeJSONTestMapping,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			expected,
+			nil,
+			"",
+		},
+		{
+			"nested tuples and arrays from decoded variable",
+			ethABIEncodeJSONTestABI,
+			"$(doc)",
+			ethABIEncodeJSONTestMapping,
+			pipeline.NewVarsFrom(map[string]interface{}{"doc": decoded}),
+			nil,
+			expected,
+			nil,
+			"",
+		},
+		{
+			"items mapping and fields from array indexes",
+			`{
+				"name": "",
+				"inputs": [
+					{ "name": "pairs", "type": "tuple[]", "components": [{ "name": "a", "type": "bool" }, { "name": "b", "type": "bytes32" }] },
+					{ "name": "matrix", "type": "uint8[][]" }
+				]
+			}`,
+			`{ "rows": [[true, "0x0000000000000000000000000000000000000000000000000000000000000001"]], "m": [[1, 2], [3]] }`,
+			`{ "pairs": { "path": "rows", "fields": { "a": "0", "b": "1" } }, "matrix": { "path": "m", "items": { "items": "" } } }`,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			itemsExpected,
+			nil,
+			"",
+		},
+		{
+			"missing mapping for argument",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.round", "feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.usd" } } }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			"mapping for argument 'window' is missing",
+		},
+		{
+			"missing key points at JSON path",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.round", "feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.eur" } }, "window": "data.window" }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			`argument feeds[0].price (int256): key "eur" not found at path "$.data.items.0.quote"`,
+		},
+		{
+			"wrong type points at JSON path",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.round", "feeds": { "path": "data.items", "fields": { "symbol": "quote.usd", "price": "quote.usd" } }, "window": "data.window" }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			`argument feeds[0].symbol (string) at path "$.data.items.0.quote.usd": expected string, got number`,
+		},
+		{
+			"negative unsigned integer",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.items.1.quote.usd", "feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.usd" } }, "window": "data.window" }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			`argument round (uint80) at path "$.data.items.1.quote.usd": -1 is negative`,
+		},
+		{
+			"object instead of array",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.round", "feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.usd" } }, "window": { "path": "data.items.0", "items": "quote.usd" } }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			`argument window (uint32[2]) at path "$.data.items.0": expected array, got object`,
+		},
+		{
+			"integer overflow",
+			`{ "name": "", "inputs": [{ "name": "small", "type": "uint8" }, { "name": "big", "type": "int256" }] }`,
+			`{ "values": [256, 1.5] }`,
+			`{ "small": "values.0", "big": "values.1" }`,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			"",
+			pipeline.ErrBadInput,
+			`argument small (uint8) at path "$.values.0": 256 does not fit into uint8`,
+		},
+		{
+			"non-integer number",
+			`{ "name": "", "inputs": [{ "name": "big", "type": "int256" }] }`,
+			`{ "values": [256, 1.5] }`,
+			`{ "big": "values.1" }`,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			"",
+			pipeline.ErrBadInput,
+			`argument big (int256) at path "$.values.1": 1.5 is not an integer`,
+		},
+		{
+			"fixed array length mismatch",
+			ethABIEncodeJSONTestABI,
+			"",
+			`{ "round": "data.round", "feeds": { "path": "data.items", "fields": { "symbol": "sym", "price": "quote.usd" } }, "window": "data.items.1.sources" }`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: ethABIEncodeJSONTestData}},
+			"",
+			pipeline.ErrBadInput,
+			`argument window (uint32[2]) at path "$.data.items.1.sources": expected 2 elements, got 0`,
+		},
+		{
+			"invalid address",
+			`{ "name": "", "inputs": [{ "name": "a", "type": "address" }] }`,
+			`{ "addr": "0x1234" }`,
+			`{ "a": "addr" }`,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			"",
+			pipeline.ErrBadInput,
+			`argument a (address) at path "$.addr": expected hex encoded address, got 0x1234`,
+		},
+		{
+			"unknown mapping key",
+			`{ "name": "", "inputs": [{ "name": "a", "type": "address" }] }`,
+			`{ "addr": "0x1234" }`,
+			`{ "a": { "pth": "addr" } }`,
+			pipeline.NewVarsFrom(nil),
+			nil,
+			"",
+			pipeline.ErrBadInput,
+			`argument a (address): unknown mapping key "pth"`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHABIEncodeJSONTask{
+				BaseTask: pipeline.NewBaseTask(0, "encode", nil, nil, 0),
+				ABI:      test.abi,
+				Data:     test.data,
+				Mapping:  test.mapping,
+			}
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+
+			if test.expectedErrorCause != nil {
+				require.Equal(t, test.expectedErrorCause, errors.Cause(result.Error))
+				require.Nil(t, result.Value)
+				if test.expectedErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.expectedErrorContains)
+				}
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ type ETHCallTask struct {
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
 	Block               string `json:"block"`
+	Quorum              string `json:"quorum"`
 
 	specGasLimit *uint32
 	legacyChains legacyevm.LegacyChainContainer
@@ -81,6 +82,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		gasUnlimited BoolParam
 		chainID      StringParam
 		block        StringParam
+		quorum       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
@@ -93,6 +95,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
 		errors.Wrap(ResolveParam(&gasUnlimited, From(VarExpr(t.GasUnlimited, vars), NonemptyString(t.GasUnlimited), false)), "gasUnlimited"),
 		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&quorum, From(VarExpr(t.Quorum, vars), NonemptyString(t.Quorum), 0)), "quorum"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -139,8 +142,15 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	var resp []byte
 	blockStr := block.String()
 	if blockStr == "" || strings.ToLower(blockStr) == "latest" {
-		resp, err = chain.Client().CallContract(ctx, call, nil)
+		if quorum > 1 {
+			resp, err = chain.Client().CallContractWithQuorum(ctx, call, nil, int(quorum))
+		} else {
+			resp, err = chain.Client().CallContract(ctx, call, nil)
+		}
 	} else if strings.ToLower(blockStr) == "pending" {
+		if quorum > 1 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "quorum is not supported for pending block")}, runInfo
+		}
 		resp, err = chain.Client().PendingCallContract(ctx, call)
 	}
 
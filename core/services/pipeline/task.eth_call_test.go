@@ -338,3 +338,52 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_Quorum(t *testing.T) {
+	t.Parallel()
+	testutils.SkipShortDB(t)
+
+	const drJobTypeGasLimit uint32 = 789
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.LimitJobType.DR = ptr(drJobTypeGasLimit)
+	})
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"foo": []byte("foo bar"),
+	})
+
+	t.Run("uses quorum read for latest block", func(t *testing.T) {
+		ethClient := evmclimocks.NewClient(t)
+		ethClient.
+			On("CallContractWithQuorum", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: uint64(drJobTypeGasLimit), Data: []byte("foo bar")}, (*big.Int)(nil), 2).
+			Return([]byte("baz quux"), nil)
+		task := pipeline.ETHCallTask{
+			BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:   contractAddr.String(),
+			Data:       "$(foo)",
+			EVMChainID: "0",
+			Quorum:     "2",
+		}
+		task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg), cfg.JobPipeline(), nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("rejects quorum for pending block", func(t *testing.T) {
+		ethClient := evmclimocks.NewClient(t)
+		task := pipeline.ETHCallTask{
+			BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:   contractAddr.String(),
+			Data:       "$(foo)",
+			EVMChainID: "0",
+			Block:      "pending",
+			Quorum:     "2",
+		}
+		task.HelperSetDependencies(cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg), cfg.JobPipeline(), nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"reflect"
 	"strconv"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-viper/mapstructure/v2"
@@ -17,6 +18,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,11 +41,16 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Blobs, if set, are sent as an EIP-4844 blob transaction
+	Blobs string `json:"blobs"`
+	// Priority is one of critical, normal or bulk and defaults to normal
+	Priority string `json:"priority"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
 	keyStore          ETHKeyStore
 	legacyChains      legacyevm.LegacyChainContainer
+	keySelectors      *keySelectors
 	jobType           string
 }
 
@@ -51,6 +58,31 @@ type ETHKeyStore interface {
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addrs ...common.Address) (common.Address, error)
 }
 
+// keySelectors holds a KeySelector per chain, shared by the ethtx tasks of all runs.
+type keySelectors struct {
+	lggr     logger.Logger
+	keyStore ETHKeyStore
+
+	mu        sync.Mutex
+	selectors map[string]*txmgr.KeySelector
+}
+
+func newKeySelectors(lggr logger.Logger, keyStore ETHKeyStore) *keySelectors {
+	return &keySelectors{lggr: lggr, keyStore: keyStore, selectors: make(map[string]*txmgr.KeySelector)}
+}
+
+func (k *keySelectors) get(chain legacyevm.Chain) *txmgr.KeySelector {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	id := chain.ID().String()
+	s, ok := k.selectors[id]
+	if !ok {
+		s = txmgr.NewKeySelector(k.lggr, chain.ID(), k.keyStore, chain.TxManager(), chain.BalanceMonitor())
+		k.selectors[id] = s
+	}
+	return s
+}
+
 var _ Task = (*ETHTxTask)(nil)
 
 func (t *ETHTxTask) Type() TaskType {
@@ -95,6 +127,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		blobs                 BytesSliceParam
+		priorityName          StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,10 +139,16 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&blobs, From(VarExpr(t.Blobs, vars), JSONWithVarExprs(t.Blobs, vars, false), nil)), "blobs"),
+		errors.Wrap(ResolveParam(&priorityName, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority), "")), "priority"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
 	}
+	priority, err := txmgrtypes.ParseTxPriority(string(priorityName))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "priority: %v", err)}, RunInfo{}
+	}
 	minOutgoingConfirmations, isMinConfirmationSet := maybeMinConfirmations.Uint64()
 
 	txMeta, err := decodeMeta(txMetaMap)
@@ -123,7 +163,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: err}, RunInfo{}
 	}
 
-	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
+	fromAddr, err := t.keySelectors.get(chain).SelectFromAddress(ctx, fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
 		lggr.Error(err)
@@ -152,6 +192,8 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		Blobs:            blobs,
+		Priority:         priority,
 	}
 
 	if !isMinConfirmationSet {
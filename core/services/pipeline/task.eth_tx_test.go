@@ -12,6 +12,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -603,4 +604,49 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_Priority(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	newTask := func(t *testing.T, priority string) (pipeline.ETHTxTask, *keystoremocks.Eth, *txmmocks.MockEvmTxManager) {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+			To:               to.Hex(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: "0",
+			EVMChainID:       "0",
+			Priority:         priority,
+		}
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+		return task, keyStore, txManager
+	}
+
+	t.Run("sets priority on the tx request", func(t *testing.T) {
+		task, keyStore, txManager := newTask(t, "critical")
+		keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+		txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(r txmgr.TxRequest) bool {
+			return r.ToAddress == to && r.Priority == txmgrtypes.TxPriorityCritical
+		})).Return(txmgr.Tx{}, nil)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("rejects unknown priority", func(t *testing.T) {
+		task, _, _ := newTask(t, "urgent")
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+		require.ErrorContains(t, result.Error, "unknown tx priority")
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
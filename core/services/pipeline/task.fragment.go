@@ -0,0 +1,85 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// FragmentTask runs a pipeline fragment stored in the database with the given
+// params, which the fragment refers to as $(params.<key>). Version 0 or
+// unset runs the latest version of the fragment.
+//
+// Return types:
+//
+//	the output of the final task of the fragment
+type FragmentTask struct {
+	BaseTask `mapstructure:",squash"`
+
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Params  string `json:"params"`
+
+	spec       Spec
+	orm        ORM
+	executeRun func(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error)
+}
+
+var _ Task = (*FragmentTask)(nil)
+
+func (t *FragmentTask) Type() TaskType {
+	return TaskTypeFragment
+}
+
+func (t *FragmentTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		name    StringParam
+		version Uint64Param
+		params  MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), NonemptyString(t.Version), 0)), "version"),
+		errors.Wrap(ResolveParam(&params, From(VarExpr(t.Params, vars), JSONWithVarExprs(t.Params, vars, false), nil)), "params"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if t.executeRun == nil {
+		return Result{Error: errors.New("pipeline fragments are not available")}, runInfo
+	}
+	depth := fragmentDepth(ctx)
+	if depth >= maxFragmentDepth {
+		return Result{Error: errors.Errorf("fragment %q exceeds the maximum fragment depth of %d", name, maxFragmentDepth)}, runInfo
+	}
+
+	fragment, spec, err := loadFragment(ctx, t.orm, t.spec, string(name), int32(version))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if params == nil {
+		params = MapParam{}
+	}
+	_, trrs, err := t.executeRun(withFragmentDepth(ctx, depth+1), spec, NewVarsFrom(map[string]interface{}{"params": map[string]interface{}(params)}))
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "failed to run fragment %s@%d", fragment.Name, fragment.Version)}, runInfo
+	}
+	fr := trrs.FinalResult()
+	if fr.HasFatalErrors() {
+		return Result{Error: errors.Wrapf(multierr.Combine(fr.FatalErrors...), "fragment %s@%d failed", fragment.Name, fragment.Version)}, runInfo
+	}
+	final, err := fr.SingularResult()
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "fragment %s@%d", fragment.Name, fragment.Version)}, runInfo
+	}
+	return Result{Value: final.Value}, runInfo
+}
@@ -0,0 +1,149 @@
+package pipeline_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestFragmentTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	orm.On("FindFragment", mock.Anything, "scale", int32(0)).Return(pipeline.Fragment{
+		Name:    "scale",
+		Version: 2,
+		DotDagSource: `
+			parse    [type=jsonparse path="price" data="$(params.body)"];
+			multiply [type=multiply times="$(params.times)"];
+			parse -> multiply;
+		`,
+	}, nil).Maybe()
+	orm.On("FindFragment", mock.Anything, "scale", int32(1)).Return(pipeline.Fragment{
+		Name:         "scale",
+		Version:      1,
+		DotDagSource: `multiply [type=multiply input="$(params.price)" times="$(params.times)"];`,
+	}, nil).Maybe()
+	orm.On("FindFragment", mock.Anything, "broken", int32(0)).Return(pipeline.Fragment{
+		Name:         "broken",
+		Version:      1,
+		DotDagSource: `fail [type=fail msg="upstream is down"];`,
+	}, nil).Maybe()
+	orm.On("FindFragment", mock.Anything, "loop", int32(0)).Return(pipeline.Fragment{
+		Name:         "loop",
+		Version:      1,
+		DotDagSource: `again [type=fragment name="loop"];`,
+	}, nil).Maybe()
+	orm.On("FindFragment", mock.Anything, "missing", int32(0)).Return(pipeline.Fragment{}, sql.ErrNoRows).Maybe()
+
+	tests := []struct {
+		name   string
+		dot    string
+		vars   map[string]interface{}
+		output string
+		err    string
+	}{
+		{
+			"latest version with params",
+			`f [type=fragment name="scale" params=<{"body": $(body), "times": 10}>]; f -> out; out [type=multiply times=2];`,
+			map[string]interface{}{"body": `{"price": 1.5}`},
+			"30",
+			"",
+		},
+		{
+			"pinned version",
+			`f [type=fragment name="scale" version=1 params=<{"price": 3, "times": $(times)}>];`,
+			map[string]interface{}{"times": 5},
+			"15",
+			"",
+		},
+		{
+			"fragment fails",
+			`f [type=fragment name="broken"];`,
+			nil,
+			"",
+			"fragment broken@1 failed: upstream is down",
+		},
+		{
+			"fragment does not exist",
+			`f [type=fragment name="missing"];`,
+			nil,
+			"",
+			`fragment "missing" version 0 does not exist`,
+		},
+		{
+			"fragment invokes itself",
+			`f [type=fragment name="loop"];`,
+			nil,
+			"",
+			"exceeds the maximum fragment depth",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: test.dot}, pipeline.NewVarsFrom(test.vars))
+			require.NoError(t, err)
+			result, err := trrs.FinalResult().SingularResult()
+			require.NoError(t, err)
+			if test.err != "" {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), test.err)
+				return
+			}
+			require.NoError(t, result.Error)
+			outputs, err := run.StringOutputs()
+			require.NoError(t, err)
+			assert.Equal(t, test.output, *outputs[0])
+		})
+	}
+}
+
+func TestValidateFragment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"valid", `a [type=jsonparse path="x" data="$(params.body)"]; b [type=multiply times=2]; a -> b;`, ""},
+		{"bad name", `a [type=memo value=1];`, "must contain only letters"},
+		{"invalid pipeline", `a [type=nope];`, "invalid fragment pipeline"},
+		{"no tasks", ``, "empty pipeline"},
+		{"several final tasks", `a [type=memo value=1]; b [type=memo value=2];`, "exactly one final task"},
+		{"async task", `a [type=bridge name=foo async=true];`, "must not contain"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			name := "price-feed_1"
+			if test.name == "bad name" {
+				name = "price feed"
+			}
+			err := pipeline.ValidateFragment(name, test.source)
+			if test.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +25,13 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CacheTTL                       string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *HTTPCache
+	httpGovernor           *HTTPGovernor
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -103,7 +107,44 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	makeRequest := func(requestCtx context.Context) (httpCacheResult, error) {
+		responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.httpGovernor, t.config.DefaultHTTPLimit())
+		if err == nil {
+			lggr.Debugw("HTTP task got response",
+				"response", string(responseBytes),
+				"respHeaders", respHeaders,
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+		}
+		return httpCacheResult{response: responseBytes, statusCode: statusCode, elapsed: elapsed}, err
+	}
+
+	var cacheTTL Uint64Param
+	if t.httpCache != nil && t.config.HTTPCacheEnabled() {
+		if err = errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.config.HTTPCacheTTL().Seconds())), "cacheTTL"); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	var res httpCacheResult
+	if cacheTTL > 0 {
+		// cacheTTL should not exceed stalenessCap.
+		cacheDuration := time.Duration(cacheTTL) * time.Second
+		if cacheDuration > stalenessCap {
+			lggr.Warnf("http task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
+			cacheDuration = stalenessCap
+		}
+		var key string
+		key, err = httpCacheKey(method, url, reqHeaders, requestData, allowUnrestrictedNetworkAccess)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		res, err = t.httpCache.getOrFetch(requestCtx, t.DotID(), key, cacheDuration, t.config.HTTPCacheMaxStaleOnError(), makeRequest)
+	} else {
+		res, err = makeRequest(requestCtx)
+	}
+	responseBytes, statusCode, elapsed := res.response, res.statusCode, res.elapsed
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
@@ -111,14 +152,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
-	lggr.Debugw("HTTP task got response",
-		"response", string(responseBytes),
-		"respHeaders", respHeaders,
-		"url", url.String(),
-		"dotID", t.DotID(),
-	)
-
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	if elapsed > 0 {
+		// Responses served from the cache did not make a request
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+	}
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
 	// NOTE: We always stringify the response since this is required for all current jobs.
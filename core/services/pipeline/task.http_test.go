@@ -1,11 +1,16 @@
 package pipeline_test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,8 +20,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
@@ -24,6 +31,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -412,3 +420,319 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.HTTPRequest.Cache.Enabled = ptr(true)
+		c.JobPipeline.HTTPRequest.Cache.TTL = commonconfig.MustNewDuration(10 * time.Second)
+		c.JobPipeline.HTTPRequest.Cache.MaxStaleOnError = commonconfig.MustNewDuration(time.Minute)
+	})
+
+	type server struct {
+		*httptest.Server
+		requests atomic.Int32
+		fail     atomic.Bool
+	}
+	newServer := func(t *testing.T, release <-chan struct{}) *server {
+		s := &server{}
+		s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := s.requests.Add(1)
+			if release != nil {
+				<-release
+			}
+			if s.fail.Load() {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, err := fmt.Fprintf(w, `{"result":%d}`, n)
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+		return s
+	}
+	newTask := func(cache *pipeline.HTTPCache, url, requestData, cacheTTL string) *pipeline.HTTPTask {
+		task := &pipeline.HTTPTask{
+			BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:      "POST",
+			URL:         url,
+			RequestData: requestData,
+			CacheTTL:    cacheTTL,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetHTTPCache(cache)
+		return task
+	}
+	run := func(t *testing.T, task *pipeline.HTTPTask) pipeline.Result {
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("serves identical requests from the cache", func(t *testing.T) {
+		s := newServer(t, nil)
+		cache := pipeline.NewHTTPCache()
+
+		require.Equal(t, `{"result":1}`, run(t, newTask(cache, s.URL, ethUSDPairing, "")).Value)
+		require.Equal(t, `{"result":1}`, run(t, newTask(cache, s.URL, ethUSDPairing, "")).Value)
+		require.Equal(t, `{"result":2}`, run(t, newTask(cache, s.URL, btcUSDPairing, "")).Value)
+		assert.Equal(t, int32(2), s.requests.Load())
+	})
+
+	t.Run("can be disabled per task", func(t *testing.T) {
+		s := newServer(t, nil)
+		cache := pipeline.NewHTTPCache()
+
+		require.Equal(t, `{"result":1}`, run(t, newTask(cache, s.URL, ethUSDPairing, "0s")).Value)
+		require.Equal(t, `{"result":2}`, run(t, newTask(cache, s.URL, ethUSDPairing, "0s")).Value)
+	})
+
+	t.Run("refetches expired responses and serves them stale on error", func(t *testing.T) {
+		s := newServer(t, nil)
+		cache := pipeline.NewHTTPCache()
+		now := time.Now()
+		cache.HelperSetNow(func() time.Time { return now })
+
+		require.Equal(t, `{"result":1}`, run(t, newTask(cache, s.URL, ethUSDPairing, "")).Value)
+
+		now = now.Add(11 * time.Second)
+		require.Equal(t, `{"result":2}`, run(t, newTask(cache, s.URL, ethUSDPairing, "")).Value)
+
+		s.fail.Store(true)
+		now = now.Add(30 * time.Second)
+		require.Equal(t, `{"result":2}`, run(t, newTask(cache, s.URL, ethUSDPairing, "")).Value)
+
+		now = now.Add(time.Minute)
+		result := run(t, newTask(cache, s.URL, ethUSDPairing, ""))
+		require.ErrorContains(t, result.Error, "status code 503")
+		require.Nil(t, result.Value)
+		assert.Equal(t, int32(4), s.requests.Load())
+	})
+
+	t.Run("coalesces concurrent identical requests", func(t *testing.T) {
+		release := make(chan struct{})
+		s := newServer(t, release)
+		cache := pipeline.NewHTTPCache()
+
+		var wg sync.WaitGroup
+		results := make([]pipeline.Result, 5)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i] = run(t, newTask(cache, s.URL, ethUSDPairing, ""))
+			}()
+		}
+		require.Eventually(t, func() bool { return s.requests.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		// Give the remaining tasks time to join the in-flight request
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		for _, result := range results {
+			require.NoError(t, result.Error)
+			require.Equal(t, `{"result":1}`, result.Value)
+		}
+		assert.Equal(t, int32(1), s.requests.Load())
+	})
+
+	t.Run("does not fail coalesced requests when the first one is cancelled", func(t *testing.T) {
+		release := make(chan struct{})
+		s := newServer(t, release)
+		cache := pipeline.NewHTTPCache()
+
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		first := make(chan pipeline.Result)
+		go func() {
+			result, _ := newTask(cache, s.URL, ethUSDPairing, "").Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			first <- result
+		}()
+		require.Eventually(t, func() bool { return s.requests.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		second := make(chan pipeline.Result)
+		go func() {
+			second <- run(t, newTask(cache, s.URL, ethUSDPairing, ""))
+		}()
+		// Give the second task time to join the in-flight request
+		time.Sleep(100 * time.Millisecond)
+
+		cancel()
+		require.ErrorIs(t, (<-first).Error, context.Canceled)
+		close(release)
+
+		result := <-second
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"result":1}`, result.Value)
+		assert.Equal(t, int32(1), s.requests.Load())
+	})
+}
+
+func TestHTTPTask_Governor(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, status *atomic.Int32, release <-chan struct{}) (*httptest.Server, *atomic.Int32) {
+		var requests atomic.Int32
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if release != nil {
+				<-release
+			}
+			if status != nil && status.Load() != 0 {
+				w.WriteHeader(int(status.Load()))
+				return
+			}
+			_, err := w.Write([]byte(`{"result":1}`))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(s.Close)
+		return s, &requests
+	}
+	newGovernor := func(t *testing.T, overrideFn func(c *toml.JobPipelineHTTPRequest)) (*pipeline.HTTPGovernor, pipeline.Config) {
+		config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			overrideFn(&c.JobPipeline.HTTPRequest)
+		})
+		return pipeline.NewHTTPGovernor(config.JobPipeline()), config.JobPipeline()
+	}
+	run := func(ctx context.Context, t *testing.T, config pipeline.Config, governor *pipeline.HTTPGovernor, url string) pipeline.Result {
+		task := pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:   "GET",
+			URL:      url,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config, c, c)
+		task.HelperSetHTTPGovernor(governor)
+		result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("circuit breaker fails fast while open", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		var status atomic.Int32
+		status.Store(http.StatusServiceUnavailable)
+		s, requests := newServer(t, &status, nil)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.CircuitBreaker.FailureThreshold = ptr[uint32](2)
+			c.CircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Minute)
+		})
+		now := time.Now()
+		governor.HelperSetNow(func() time.Time { return now })
+		host := strings.TrimPrefix(s.URL, "http://")
+
+		for i := 0; i < 2; i++ {
+			require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "status code 503")
+		}
+		result := run(ctx, t, config, governor, s.URL)
+		require.ErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+		assert.Equal(t, int32(2), requests.Load())
+		report := governor.HealthReport("HTTPGovernor")
+		require.ErrorContains(t, report["HTTPGovernor."+host], "circuit breaker open after 2 consecutive failures")
+
+		// a failed trial request opens the breaker again
+		now = now.Add(time.Minute)
+		require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "status code 503")
+		require.ErrorIs(t, run(ctx, t, config, governor, s.URL).Error, pipeline.ErrCircuitOpen)
+		assert.Equal(t, int32(3), requests.Load())
+
+		// a successful trial request closes the breaker
+		now = now.Add(time.Minute)
+		status.Store(0)
+		require.NoError(t, run(ctx, t, config, governor, s.URL).Error)
+		require.NoError(t, run(ctx, t, config, governor, s.URL).Error)
+		assert.Equal(t, int32(5), requests.Load())
+		require.NoError(t, governor.HealthReport("HTTPGovernor")["HTTPGovernor."+host])
+	})
+
+	t.Run("client errors do not open the circuit breaker", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		var status atomic.Int32
+		status.Store(http.StatusBadRequest)
+		s, requests := newServer(t, &status, nil)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.CircuitBreaker.FailureThreshold = ptr[uint32](1)
+		})
+
+		for i := 0; i < 3; i++ {
+			require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "status code 400")
+		}
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("interrupted requests do not open the circuit breaker", func(t *testing.T) {
+		release := make(chan struct{})
+		s, requests := newServer(t, nil, release)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.CircuitBreaker.FailureThreshold = ptr[uint32](1)
+		})
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "http request timed out or interrupted")
+
+		close(release)
+		require.NoError(t, run(testutils.Context(t), t, config, governor, s.URL).Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("evicts idle hosts", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		var status atomic.Int32
+		status.Store(http.StatusServiceUnavailable)
+		idle, _ := newServer(t, &status, nil)
+		active, _ := newServer(t, nil, nil)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.CircuitBreaker.FailureThreshold = ptr[uint32](1)
+			c.CircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(time.Minute)
+		})
+		now := time.Now()
+		governor.HelperSetNow(func() time.Time { return now })
+
+		require.ErrorContains(t, run(ctx, t, config, governor, idle.URL).Error, "status code 503")
+		require.NoError(t, run(ctx, t, config, governor, active.URL).Error)
+		assert.Len(t, governor.HelperHosts(), 2)
+
+		now = now.Add(time.Hour)
+		require.NoError(t, run(ctx, t, config, governor, active.URL).Error)
+		assert.Equal(t, []string{strings.TrimPrefix(active.URL, "http://")}, governor.HelperHosts())
+		// the open circuit breaker of the idle host is gone with it
+		require.ErrorContains(t, run(ctx, t, config, governor, idle.URL).Error, "status code 503")
+	})
+
+	t.Run("limits concurrent requests per host", func(t *testing.T) {
+		release := make(chan struct{})
+		s, requests := newServer(t, nil, release)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.HostLimits.MaxConcurrency = ptr[uint32](1)
+		})
+
+		done := make(chan pipeline.Result)
+		go func() {
+			done <- run(testutils.Context(t), t, config, governor, s.URL)
+		}()
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "limit of 1 concurrent requests")
+
+		close(release)
+		require.NoError(t, (<-done).Error)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("limits requests per second per host", func(t *testing.T) {
+		s, requests := newServer(t, nil, nil)
+		governor, config := newGovernor(t, func(c *toml.JobPipelineHTTPRequest) {
+			c.HostLimits.RPS = ptr(0.001)
+			c.HostLimits.Burst = ptr(1)
+		})
+
+		require.NoError(t, run(testutils.Context(t), t, config, governor, s.URL).Error)
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), time.Second)
+		defer cancel()
+		require.ErrorContains(t, run(ctx, t, config, governor, s.URL).Error, "rate limit of 0.001 requests per second")
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
@@ -27,6 +27,10 @@ type JSONParseTask struct {
 	Path      string `json:"path"`
 	Separator string `json:"separator"`
 	Data      string `json:"data"`
+	// Query is a JSONPath query, which is used instead of Path. Singular
+	// queries, such as $.data[0].price, return the selected value, and all
+	// other queries return an array of the selected values.
+	Query string `json:"query"`
 	// Lax when disabled will return an error if the path does not exist
 	// Lax when enabled will return nil with no error if the path does not exist
 	Lax string
@@ -38,7 +42,7 @@ func (t *JSONParseTask) Type() TaskType {
 	return TaskTypeJSONParse
 }
 
-func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+func (t *JSONParseTask) Run(ctx context.Context, l logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	_, err := CheckInputs(inputs, 0, 1, 0)
 	if err != nil {
 		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
@@ -47,12 +51,14 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 	var sep StringParam
 	err = errors.Wrap(ResolveParam(&sep, From(t.Separator)), "separator")
 	var (
-		path = NewJSONPathParam(string(sep))
-		data BytesParam
-		lax  BoolParam
+		path  = NewJSONPathParam(string(sep))
+		data  BytesParam
+		lax   BoolParam
+		query StringParam
 	)
 	err = multierr.Combine(err,
 		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), t.Path)), "path"),
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), t.Query)), "query"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
 		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
 	)
@@ -60,6 +66,10 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 		return Result{Error: err}, runInfo
 	}
 
+	if query != "" && len(path) > 0 {
+		return Result{Error: errors.Wrap(ErrBadInput, "only one of path and query can be set")}, runInfo
+	}
+
 	var decoded interface{}
 	d := json.NewDecoder(bytes.NewReader(data))
 	d.UseNumber()
@@ -68,6 +78,14 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 		return Result{Error: err}, runInfo
 	}
 
+	if query != "" {
+		decoded, err = t.runQuery(ctx, string(query), decoded, bool(lax))
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		path = nil
+	}
+
 	for _, part := range path {
 		switch d := decoded.(type) {
 		case map[string]interface{}:
@@ -117,3 +135,28 @@ func (t *JSONParseTask) Run(_ context.Context, l logger.Logger, vars Vars, input
 
 	return Result{Value: decoded}, runInfo
 }
+
+// runQuery returns the result of the JSONPath query on decoded.
+func (t *JSONParseTask) runQuery(ctx context.Context, query string, decoded interface{}, lax bool) (interface{}, error) {
+	q, err := ParseJSONQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := q.Evaluate(ctx, decoded)
+	if err != nil {
+		return nil, err
+	}
+	if !q.Singular() {
+		if len(nodes) == 0 && !lax {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "query %s selected no values", query)
+		}
+		return nodes, nil
+	}
+	if len(nodes) == 0 {
+		if lax {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(ErrKeypathNotFound, "could not resolve query %s", query)
+	}
+	return nodes[0], nil
+}
@@ -420,3 +420,60 @@ func TestJSONParseTask(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONParseTask_Query(t *testing.T) {
+	t.Parallel()
+
+	data := `{"data": [
+		{"symbol": "BTC", "price": 65000.5, "volume": 10},
+		{"symbol": "ETH", "price": 3000.25, "volume": 100},
+		{"symbol": "LINK", "price": 15, "volume": 1000}
+	]}`
+
+	tests := []struct {
+		name              string
+		query             string
+		path              string
+		lax               string
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"filter", "$.data[?@.symbol == 'ETH'].price", "", "", []interface{}{3000.25}, nil, ""},
+		{"filter with parentheses", `$.data[?(@.volume >= 100 && @.symbol != "LINK")].symbol`, "", "", []interface{}{"ETH"}, nil, ""},
+		{"wildcard", "$.data[*].volume", "", "", []interface{}{int64(10), int64(100), int64(1000)}, nil, ""},
+		{"slice", "$.data[-2:].symbol", "", "", []interface{}{"ETH", "LINK"}, nil, ""},
+		{"descendants", "$..symbol", "", "", []interface{}{"BTC", "ETH", "LINK"}, nil, ""},
+		{"singular query", "$.data[1].price", "", "", 3000.25, nil, ""},
+		{"singular query not found", "$.data[5].price", "", "", nil, pipeline.ErrKeypathNotFound, "could not resolve query"},
+		{"singular query not found lax", "$.data[5].price", "", "true", nil, nil, ""},
+		{"no matches", "$.data[?@.symbol == 'DOGE'].price", "", "", nil, pipeline.ErrKeypathNotFound, "selected no values"},
+		{"no matches lax", "$.data[?@.symbol == 'DOGE'].price", "", "true", []interface{}{}, nil, ""},
+		{"invalid query", "$.data[?@.symbol ==]", "", "", nil, pipeline.ErrBadInput, "invalid JSONPath query"},
+		{"path and query", "$.data", "data", "", nil, pipeline.ErrBadInput, "only one of path and query"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONParseTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Path:     test.path,
+				Query:    test.query,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// MapTask runs a nested pipeline once for each element of the input array,
+// and returns the outputs of the final task of each run in the same order.
+// The nested pipeline is either given inline as source, or is the fragment
+// with the given name and version (0 or unset for the latest). It refers to
+// the element as $(element), to its position as $(index) and to params as
+// $(params.<key>). Inline sources are quoted in angle brackets, or in double
+// quotes with inner quotes escaped; the former cannot contain explicit edges,
+// so their tasks should refer to each other with variables instead.
+// At most concurrency elements (default 1, at most 100) run at a time.
+// The result of each element is recorded as a task run with the DOT ID
+// <map task>[<index>]. The task fails if any of the elements fail.
+//
+// Return types:
+//
+//	[]interface{}
+type MapTask struct {
+	BaseTask `mapstructure:",squash"`
+
+	Input       string `json:"input"`
+	Source      string `json:"source"`
+	Fragment    string `json:"fragment"`
+	Version     string `json:"version"`
+	Params      string `json:"params"`
+	Concurrency string `json:"concurrency"`
+
+	spec       Spec
+	orm        ORM
+	executeRun func(ctx context.Context, spec Spec, vars Vars) (*Run, TaskRunResults, error)
+}
+
+var _ Task = (*MapTask)(nil)
+
+// maxMapConcurrency is the maximum number of elements a map task may run at a time.
+const maxMapConcurrency = 100
+
+var mapElementDotIDRegex = regexp.MustCompile(`^(.+)\[\d+\]$`)
+
+// mapElementDotID is the DOT ID of the task run recording the element at index of the map task dotID.
+func mapElementDotID(dotID string, index int) string {
+	return fmt.Sprintf("%s[%d]", dotID, index)
+}
+
+// isMapElementDotID reports whether dotID is that of a task run recording an
+// element of a map task, which has no task of its own in the pipeline.
+func isMapElementDotID(p *Pipeline, dotID string) bool {
+	m := mapElementDotIDRegex.FindStringSubmatch(dotID)
+	if m == nil {
+		return false
+	}
+	task := p.ByDotID(m[1])
+	return task != nil && task.Type() == TaskTypeMap
+}
+
+// source returns the inline source with the quoting of the DOT attribute removed.
+func (t *MapTask) source() string {
+	source := strings.TrimSpace(t.Source)
+	if strings.HasPrefix(source, "<") && strings.HasSuffix(source, ">") {
+		return source[1 : len(source)-1]
+	}
+	return strings.ReplaceAll(source, `\"`, `"`)
+}
+
+func (t *MapTask) Type() TaskType {
+	return TaskTypeMap
+}
+
+// validate checks the nested pipeline of the task, if it is given inline, so
+// that invalid sources are rejected when the job is created.
+func (t *MapTask) validate() error {
+	if (t.Source == "") == (t.Fragment == "") {
+		return errors.Errorf("map task %q: exactly one of source and fragment must be set", t.DotID())
+	}
+	if t.Source != "" {
+		return errors.Wrapf(validateNestedPipeline(t.source()), "map task %q: invalid source", t.DotID())
+	}
+	return nil
+}
+
+func (t *MapTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		input       SliceParam
+		version     Uint64Param
+		params      MapParam
+		concurrency Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&input, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&version, From(VarExpr(t.Version, vars), NonemptyString(t.Version), 0)), "version"),
+		errors.Wrap(ResolveParam(&params, From(VarExpr(t.Params, vars), JSONWithVarExprs(t.Params, vars, false), nil)), "params"),
+		errors.Wrap(ResolveParam(&concurrency, From(VarExpr(t.Concurrency, vars), NonemptyString(t.Concurrency), 1)), "concurrency"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if (t.Source == "") == (t.Fragment == "") {
+		return Result{Error: errors.Wrap(ErrBadInput, "exactly one of source and fragment must be set")}, runInfo
+	}
+	if concurrency == 0 || concurrency > maxMapConcurrency {
+		return Result{Error: errors.Wrapf(ErrBadInput, "concurrency must be between 1 and %d", maxMapConcurrency)}, runInfo
+	}
+
+	if t.executeRun == nil {
+		return Result{Error: errors.New("map tasks are not available")}, runInfo
+	}
+	depth := fragmentDepth(ctx)
+	if depth >= maxFragmentDepth {
+		return Result{Error: errors.Errorf("map task %q exceeds the maximum fragment depth of %d", t.DotID(), maxFragmentDepth)}, runInfo
+	}
+
+	spec := nestedSpec(t.spec, t.source())
+	if t.Fragment != "" {
+		_, spec, err = loadFragment(ctx, t.orm, t.spec, t.Fragment, int32(version))
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+	if err = validateNestedPipeline(spec.DotDagSource); err != nil {
+		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+	}
+	if params == nil {
+		params = MapParam{}
+	}
+
+	elements := make([]TaskRunResult, len(input))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, min(int(concurrency), len(input)))
+	for i, element := range input {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			// elements which were not started yet fail with the run
+			now := time.Now()
+			for j := i; j < len(input); j++ {
+				elements[j] = TaskRunResult{ID: uuid.New(), Result: Result{Error: ctx.Err()}, CreatedAt: now, FinishedAt: null.TimeFrom(now)}
+			}
+			break
+		}
+		wg.Add(1)
+		go func(i int, element interface{}) {
+			defer func() { <-sem; wg.Done() }()
+			elements[i] = t.runElement(withFragmentDepth(ctx, depth+1), spec, NewVarsFrom(map[string]interface{}{
+				"element": element,
+				"index":   i,
+				"params":  map[string]interface{}(params),
+			}))
+		}(i, element)
+	}
+	wg.Wait()
+	runInfo.elements = elements
+
+	values := make([]interface{}, len(elements))
+	for i, element := range elements {
+		if element.Result.Error != nil {
+			err = multierr.Append(err, errors.Wrapf(element.Result.Error, "element %d", i))
+			continue
+		}
+		values[i] = element.Result.Value
+	}
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: values}, runInfo
+}
+
+// runElement runs the nested pipeline for a single element and returns the
+// result of its final task.
+func (t *MapTask) runElement(ctx context.Context, spec Spec, vars Vars) TaskRunResult {
+	start := time.Now()
+	element := TaskRunResult{ID: uuid.New(), CreatedAt: start}
+	_, trrs, err := t.executeRun(ctx, spec, vars)
+	element.FinishedAt = null.TimeFrom(time.Now())
+	if err != nil {
+		element.Result = Result{Error: err}
+		return element
+	}
+	fr := trrs.FinalResult()
+	if fr.HasFatalErrors() {
+		element.Result = Result{Error: multierr.Combine(fr.FatalErrors...)}
+		return element
+	}
+	final, err := fr.SingularResult()
+	if err != nil {
+		element.Result = Result{Error: err}
+		return element
+	}
+	element.Result = Result{Value: final.Value}
+	return element
+}
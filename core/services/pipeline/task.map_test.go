@@ -0,0 +1,209 @@
+package pipeline_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func TestMapTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	orm.On("FindFragment", mock.Anything, "scale", int32(0)).Return(pipeline.Fragment{
+		Name:         "scale",
+		Version:      1,
+		DotDagSource: `multiply [type=multiply input="$(element.price)" times="$(params.times)"];`,
+	}, nil).Maybe()
+
+	tests := []struct {
+		name     string
+		dot      string
+		vars     map[string]interface{}
+		output   []interface{}
+		err      string
+		elements []string
+	}{
+		{
+			"inline source in angle brackets",
+			`m [type=map input="$(prices)" concurrency=2 source=<a [type=multiply input="$(element)" times=2]; b [type=sum values=<[ $(a), $(index) ]>];>];`,
+			map[string]interface{}{"prices": []interface{}{1, 2, 3}},
+			[]interface{}{"2", "5", "8"},
+			"",
+			[]string{"2", "5", "8"},
+		},
+		{
+			"inline source in double quotes",
+			`m [type=map input="$(prices)" source="a [type=multiply input=\"$(element)\" times=\"$(params.times)\"]; b [type=multiply times=2]; a -> b;" params=<{"times": 10}>];`,
+			map[string]interface{}{"prices": []interface{}{1, 2}},
+			[]interface{}{"20", "40"},
+			"",
+			[]string{"20", "40"},
+		},
+		{
+			"fragment with array from input",
+			`ds [type=memo value=<[{"price": 1.5}, {"price": 2}]>]; m [type=map fragment="scale" params=<{"times": 4}>]; ds -> m;`,
+			nil,
+			[]interface{}{"6", "8"},
+			"",
+			[]string{"6", "8"},
+		},
+		{
+			"empty array",
+			`m [type=map input="$(prices)" source=<a [type=multiply input="$(element)" times=2];>];`,
+			map[string]interface{}{"prices": []interface{}{}},
+			[]interface{}{},
+			"",
+			nil,
+		},
+		{
+			"element fails",
+			`m [type=map input="$(prices)" concurrency=3 source=<a [type=multiply input="$(element)" times=2];>];`,
+			map[string]interface{}{"prices": []interface{}{1, "foo", 3}},
+			nil,
+			"element 1",
+			[]string{"2", "", "6"},
+		},
+		{
+			"zero concurrency",
+			`m [type=map input="$(prices)" concurrency=0 source=<a [type=multiply input="$(element)" times=2];>];`,
+			map[string]interface{}{"prices": []interface{}{1}},
+			nil,
+			"concurrency must be between 1 and 100",
+			nil,
+		},
+		{
+			"concurrency too large",
+			`m [type=map input="$(prices)" concurrency="18446744073709551615" source=<a [type=multiply input="$(element)" times=2];>];`,
+			map[string]interface{}{"prices": []interface{}{1}},
+			nil,
+			"concurrency must be between 1 and 100",
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			run, trrs, err := r.ExecuteRun(testutils.Context(t), pipeline.Spec{DotDagSource: test.dot}, pipeline.NewVarsFrom(test.vars))
+			require.NoError(t, err)
+			result, err := trrs.FinalResult().SingularResult()
+			require.NoError(t, err)
+			if test.err != "" {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), test.err)
+			} else {
+				require.NoError(t, result.Error)
+				values, ok := result.Value.([]interface{})
+				require.True(t, ok)
+				require.Len(t, values, len(test.output))
+				for i := range values {
+					assert.Equal(t, test.output[i], values[i].(interface{ String() string }).String())
+				}
+			}
+
+			// every element is recorded as a task run of its own
+			elements := map[string]pipeline.TaskRun{}
+			for _, tr := range run.PipelineTaskRuns {
+				if tr.DotID != "m" && tr.DotID != "ds" {
+					elements[tr.DotID] = tr
+				}
+			}
+			require.Len(t, elements, len(test.elements))
+			for i, output := range test.elements {
+				tr := elements["m["+string(rune('0'+i))+"]"]
+				assert.Equal(t, pipeline.TaskTypeMap, tr.Type)
+				if output == "" {
+					assert.True(t, tr.Error.Valid)
+					continue
+				}
+				assert.False(t, tr.Error.Valid)
+				assert.Equal(t, output, tr.Output.Val.(interface{ String() string }).String())
+			}
+			// elements do not count as outputs of the run
+			assert.Len(t, run.FatalErrors, 1)
+		})
+	}
+}
+
+func TestMapTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		dot  string
+		err  string
+	}{
+		{
+			"source and fragment",
+			`m [type=map input="$(prices)" fragment="scale" source=<a [type=multiply input="$(element)" times=2];>];`,
+			"exactly one of source and fragment must be set",
+		},
+		{
+			"neither source nor fragment",
+			`m [type=map input="$(prices)"];`,
+			"exactly one of source and fragment must be set",
+		},
+		{
+			"source with several final tasks",
+			`m [type=map input="$(prices)" source=<a [type=memo value=1]; b [type=memo value=2];>];`,
+			"exactly one final task",
+		},
+		{
+			"source with unknown task",
+			`m [type=map input="$(prices)" source=<a [type=foo];>];`,
+			"unknown task type",
+		},
+	} {
+		_, err := pipeline.Parse(test.dot)
+		require.Error(t, err, test.name)
+		assert.Contains(t, err.Error(), test.err, test.name)
+	}
+
+	_, err := pipeline.Parse(`m [type=map input="$(prices)" source=<a [type=multiply input="$(element)" times=2];>];`)
+	require.NoError(t, err)
+}
+
+func TestMapTask_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	ctx, cancel := context.WithCancel(testutils.Context(t))
+	defer cancel()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cancel()
+		<-r.Context().Done()
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := &http.Client{}
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), c, c)
+	spec := pipeline.Spec{DotDagSource: `m [type=map input="$(prices)" source=<a [type=http method=GET url="$(params.url)" allowUnrestrictedNetworkAccess=true];> params=<{"url": $(url)}>];`}
+
+	_, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(map[string]interface{}{"prices": []interface{}{1, 2, 3, 4}, "url": s.URL}))
+	require.NoError(t, err)
+	result, err := trrs.FinalResult().SingularResult()
+	require.NoError(t, err)
+	require.Error(t, result.Error)
+	// no element is started after the run is cancelled
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -538,6 +538,18 @@ func (s *SliceParam) UnmarshalPipelineParam(val interface{}) error {
 		}
 		*s = SliceParam(theSlice)
 		return nil
+
+	case ObjectParam:
+		if v.Type == SliceType {
+			*s = v.SliceValue
+			return nil
+		}
+
+	case *ObjectParam:
+		if v != nil && v.Type == SliceType {
+			*s = v.SliceValue
+			return nil
+		}
 	}
 
 	return errors.Wrapf(ErrBadInput, "expected slice, got %T", val)
@@ -678,6 +690,45 @@ func (s *AddressSliceParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// BytesSliceParam is a list of byte arrays, where each element is either raw bytes or a hex-encoded string
+type BytesSliceParam [][]byte
+
+func (s *BytesSliceParam) UnmarshalPipelineParam(val interface{}) error {
+	var bsp BytesSliceParam
+	switch v := val.(type) {
+	case nil:
+		bsp = nil
+	case [][]byte:
+		bsp = v
+	case string:
+		var strs []string
+		if err := json.Unmarshal([]byte(v), &strs); err != nil {
+			return errors.Wrapf(ErrBadInput, "BytesSliceParam: %v", err)
+		}
+		return s.UnmarshalPipelineParam(strs)
+	case []string:
+		for _, str := range v {
+			var b BytesParam
+			if err := b.UnmarshalPipelineParam(str); err != nil {
+				return errors.Wrapf(ErrBadInput, "BytesSliceParam: %v", err)
+			}
+			bsp = append(bsp, b)
+		}
+	case []interface{}:
+		for _, x := range v {
+			var b BytesParam
+			if err := b.UnmarshalPipelineParam(x); err != nil {
+				return errors.Wrapf(ErrBadInput, "BytesSliceParam: %v", err)
+			}
+			bsp = append(bsp, b)
+		}
+	default:
+		return errors.Wrapf(ErrBadInput, "BytesSliceParam: cannot convert %T", val)
+	}
+	*s = bsp
+	return nil
+}
+
 type JSONPathParam []string
 
 // NewJSONPathParam returns a new JSONPathParam using the given separator, or the default if empty.
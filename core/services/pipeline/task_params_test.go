@@ -545,6 +545,8 @@ func TestSliceParam_UnmarshalPipelineParam(t *testing.T) {
 		{"string", `[1, 2, 3]`, pipeline.SliceParam([]interface{}{float64(1), float64(2), float64(3)}), nil},
 		{"bool", true, pipeline.SliceParam(nil), pipeline.ErrBadInput},
 		{"nil", nil, pipeline.SliceParam(nil), nil},
+		{"object", pipeline.ObjectParam{Type: pipeline.SliceType, SliceValue: pipeline.SliceParam{1, 2}}, pipeline.SliceParam([]interface{}{1, 2}), nil},
+		{"object of other type", pipeline.ObjectParam{Type: pipeline.BoolType, BoolValue: true}, pipeline.SliceParam(nil), pipeline.ErrBadInput},
 	}
 
 	for _, test := range tests {
@@ -592,6 +594,39 @@ func TestHashSliceParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestBytesSliceParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	expected := pipeline.BytesSliceParam{hexutil.MustDecode("0xd3184d"), []byte("foo")}
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+		err      error
+	}{
+		{"json", `[ "0xd3184d", "foo" ]`, expected, nil},
+		{"[][]byte", [][]byte{hexutil.MustDecode("0xd3184d"), []byte("foo")}, expected, nil},
+		{"[]string", []string{"0xd3184d", "foo"}, expected, nil},
+		{"[]interface{} with strings and []byte", []interface{}{"0xd3184d", []byte("foo")}, expected, nil},
+		{"nil", nil, pipeline.BytesSliceParam(nil), nil},
+		{"bad json", `[ "0xd3184d" "foo" ]`, nil, pipeline.ErrBadInput},
+		{"[]interface{} with bad types", []interface{}{123, true}, nil, pipeline.ErrBadInput},
+		{"int", 123, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.BytesSliceParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			if test.expected != nil {
+				require.Equal(t, test.expected, p)
+			}
+		})
+	}
+}
+
 func TestSliceParam_FilterErrors(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,7 @@ import (
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/aggregator_v3_interface"
@@ -95,6 +96,7 @@ func New(
 		job:                   job,
 		ds:                    ds,
 		gethks:                gethks,
+		keySelector:           txmgr.NewKeySelector(l, chainID, gethks, chain.TxManager(), chain.BalanceMonitor()),
 		chStop:                make(chan struct{}),
 		reqAdded:              reqAdded,
 		blockNumberToReqID:    pairing.New(),
@@ -122,6 +124,7 @@ type listenerV2 struct {
 	job            job.Job
 	ds             sqlutil.DataSource
 	gethks         keystore.Eth
+	keySelector    *txmgr.KeySelector
 	chStop         services.StopChan
 
 	reqAdded func() // A simple debug helper
@@ -18,6 +18,7 @@ type Delegate struct {
 	registry core.CapabilitiesRegistry
 	logger   logger.Logger
 	store    store.Store
+	engines  *EngineRegistry
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -56,6 +57,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		Store:         d.store,
 		Config:        []byte(spec.WorkflowSpec.Config),
 		Binary:        binary,
+		Engines:       d.engines,
+
+		MaxExecutionDuration: spec.WorkflowSpec.MaxExecutionDuration.Duration(),
 	}
 	engine, err := NewEngine(cfg)
 	if err != nil {
@@ -68,8 +72,9 @@ func NewDelegate(
 	logger logger.Logger,
 	registry core.CapabilitiesRegistry,
 	store store.Store,
+	engines *EngineRegistry,
 ) *Delegate {
-	return &Delegate{logger: logger, registry: registry, store: store}
+	return &Delegate{logger: logger, registry: registry, store: store, engines: engines}
 }
 
 func ValidatedWorkflowJobSpec(ctx context.Context, tomlString string) (job.Job, error) {
@@ -35,8 +35,32 @@ type stepRequest struct {
 type stepUpdateChannel struct {
 	executionID string
 	ch          chan store.WorkflowExecutionStep
+	// ctx is canceled once the execution finishes, times out or is canceled,
+	// which interrupts the capabilities executing its steps.
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	// done is closed once the stepUpdateLoop of the execution has returned.
+	done chan struct{}
 }
 
+func newStepUpdateChannel(ctx context.Context, executionID string) stepUpdateChannel {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return stepUpdateChannel{
+		executionID: executionID,
+		ch:          make(chan store.WorkflowExecutionStep),
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+var (
+	errExecutionCancelled = errors.New("execution cancelled")
+	errExecutionTimedOut  = errors.New("execution timed out")
+	errExecutionFinished  = errors.New("execution finished")
+	errStepTimedOut       = errors.New("step timed out")
+)
+
 type stepUpdateManager struct {
 	mu sync.RWMutex
 	m  map[string]stepUpdateChannel
@@ -58,15 +82,38 @@ func (sucm *stepUpdateManager) add(executionID string, ch stepUpdateChannel) (ad
 	return true
 }
 
+// remove stops the stepUpdateLoop of the execution. The channel is not closed, since
+// workers may still be sending the updates of steps that were in flight.
 func (sucm *stepUpdateManager) remove(executionID string) {
 	sucm.mu.Lock()
 	defer sucm.mu.Unlock()
-	if _, ok := sucm.m[executionID]; ok {
-		close(sucm.m[executionID].ch)
+	if ch, ok := sucm.m[executionID]; ok {
+		ch.cancel(errExecutionFinished)
 		delete(sucm.m, executionID)
 	}
 }
 
+// cancel cancels the context of the execution with the given cause, and returns a
+// channel that is closed once its stepUpdateLoop has returned, or false if the execution is not running.
+func (sucm *stepUpdateManager) cancel(executionID string, cause error) (<-chan struct{}, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	ch, ok := sucm.m[executionID]
+	if !ok {
+		return nil, false
+	}
+	ch.cancel(cause)
+	return ch.done, true
+}
+
+// ctx returns the context of the execution, if it is running.
+func (sucm *stepUpdateManager) ctx(executionID string) (context.Context, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	ch, ok := sucm.m[executionID]
+	return ch.ctx, ok
+}
+
 func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, stepUpdate store.WorkflowExecutionStep) error {
 	sucm.mu.RLock()
 	stepUpdateCh, ok := sucm.m[executionID]
@@ -78,6 +125,8 @@ func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, ste
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("context canceled before step update could be issued: %w", context.Cause(ctx))
+	case <-stepUpdateCh.ctx.Done():
+		return fmt.Errorf("execution ended before step update could be issued: %w", context.Cause(stepUpdateCh.ctx))
 	case stepUpdateCh.ch <- stepUpdate:
 		return nil
 	}
@@ -122,6 +171,8 @@ type Engine struct {
 	maxWorkerLimit int
 
 	clock clockwork.Clock
+
+	engineRegistry *EngineRegistry
 }
 
 func (e *Engine) Start(_ context.Context) error {
@@ -143,10 +194,33 @@ func (e *Engine) Start(_ context.Context) error {
 		e.wg.Add(1)
 		go e.init(ctx)
 
+		if e.engineRegistry != nil {
+			e.engineRegistry.add(e)
+		}
 		return nil
 	})
 }
 
+// ErrExecutionNotRunning is returned when canceling an execution that is not running.
+var ErrExecutionNotRunning = errors.New("workflow execution is not running")
+
+// CancelExecution cancels a running execution of this workflow, and returns once it is
+// marked as cancelled. Its remaining steps are no longer dispatched, and the
+// capabilities executing its current steps are interrupted.
+func (e *Engine) CancelExecution(ctx context.Context, executionID string) error {
+	done, ok := e.stepUpdatesChMap.cancel(executionID, errExecutionCancelled)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrExecutionNotRunning, executionID)
+	}
+
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-done:
+		return nil
+	}
+}
+
 // resolveWorkflowCapabilities does the following:
 //
 // 1. Resolves the underlying capability for each trigger
@@ -212,26 +286,44 @@ func (e *Engine) resolveWorkflowCapabilities(ctx context.Context) error {
 }
 
 func (e *Engine) initializeCapability(ctx context.Context, step *step) error {
+	// If the capability already exists, that means we've already registered it
+	if step.capability == nil {
+		cc, info, config, err := e.registerCapability(ctx, step.ID, step.Config, step.config)
+		if err != nil {
+			return err
+		}
+		step.capability, step.info, step.config = cc, info, config
+	}
+
+	if step.fallback != nil && step.fallback.capability == nil {
+		fb := step.fallback
+		cc, info, config, err := e.registerCapability(ctx, fb.ID, fb.Config, fb.config)
+		if err != nil {
+			return err
+		}
+		fb.capability, fb.info, fb.config = cc, info, config
+	}
+	return nil
+}
+
+// registerCapability resolves the capability with the given ID and registers it to
+// this workflow. The config is interpolated from rawConfig, unless it is already given.
+func (e *Engine) registerCapability(ctx context.Context, id string, rawConfig map[string]any, config *values.Map) (capabilities.ExecutableCapability, capabilities.CapabilityInfo, *values.Map, error) {
 	// We use varadic err here so that err can be optional, but we assume that
 	// its length is either 0 or 1
-	newCPErr := func(reason string, errs ...error) *workflowError {
+	newCPErr := func(reason string, errs ...error) (capabilities.ExecutableCapability, capabilities.CapabilityInfo, *values.Map, error) {
 		var err error
 		if len(errs) > 0 {
 			err = errs[0]
 		}
 
-		return &workflowError{reason: reason, err: err, labels: map[string]string{
+		return nil, capabilities.CapabilityInfo{}, nil, &workflowError{reason: reason, err: err, labels: map[string]string{
 			wIDKey: e.workflow.id,
-			sIDKey: step.ID,
+			sIDKey: id,
 		}}
 	}
 
-	// If the capability already exists, that means we've already registered it
-	if step.capability != nil {
-		return nil
-	}
-
-	cp, err := e.registry.Get(ctx, step.ID)
+	cp, err := e.registry.Get(ctx, id)
 	if err != nil {
 		return newCPErr("failed to get capability", err)
 	}
@@ -241,16 +333,14 @@ func (e *Engine) initializeCapability(ctx context.Context, step *step) error {
 		return newCPErr("failed to get capability info", err)
 	}
 
-	step.info = info
-
 	// Special treatment for local targets - wrap into a transmission capability
 	// If the DON is nil, this is a local target.
 	if info.CapabilityType == capabilities.CapabilityTypeTarget && info.IsLocal {
-		l := e.logger.With("capabilityID", step.ID)
+		l := e.logger.With("capabilityID", id)
 		l.Debug("wrapping capability in local transmission protocol")
 		cp = transmission.NewLocalTargetCapability(
 			e.logger,
-			step.ID,
+			id,
 			e.localNode,
 			cp.(capabilities.TargetCapability),
 		)
@@ -263,29 +353,29 @@ func (e *Engine) initializeCapability(ctx context.Context, step *step) error {
 		return newCPErr("capability does not satisfy CallbackCapability")
 	}
 
-	if step.config == nil {
-		c, interpErr := exec.FindAndInterpolateEnvVars(step.Config, e.env)
+	if config == nil {
+		c, interpErr := exec.FindAndInterpolateEnvVars(rawConfig, e.env)
 		if interpErr != nil {
 			return newCPErr("failed to convert interpolate env vars from config", interpErr)
 		}
 
-		config, ok := c.(map[string]any)
+		configAny, ok := c.(map[string]any)
 		if !ok {
 			return newCPErr("failed to convert interpolate env vars from config into map")
 		}
 
-		configMap, newMapErr := values.NewMap(config)
+		configMap, newMapErr := values.NewMap(configAny)
 		if newMapErr != nil {
 			return newCPErr("failed to convert config to values.Map", newMapErr)
 		}
-		step.config = configMap
+		config = configMap
 	}
 
 	registrationRequest := capabilities.RegisterToWorkflowRequest{
 		Metadata: capabilities.RegistrationMetadata{
 			WorkflowID: e.workflow.id,
 		},
-		Config: step.config,
+		Config: config,
 	}
 
 	err = cc.RegisterToWorkflow(ctx, registrationRequest)
@@ -293,8 +383,7 @@ func (e *Engine) initializeCapability(ctx context.Context, step *step) error {
 		return newCPErr(fmt.Sprintf("failed to register capability to workflow (%+v)", registrationRequest), err)
 	}
 
-	step.capability = cc
-	return nil
+	return cc, info, config, nil
 }
 
 // init does the following:
@@ -397,15 +486,14 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 			}
 
 			for _, sd := range sds {
-				ch := make(chan store.WorkflowExecutionStep)
-				added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
-					ch:          ch,
-					executionID: execution.ExecutionID,
-				})
+				ch := newStepUpdateChannel(ctx, execution.ExecutionID)
+				added := e.stepUpdatesChMap.add(execution.ExecutionID, ch)
 				if added {
 					// We trigger the `stepUpdateLoop` for this execution, since the loop is not running atm.
 					e.wg.Add(1)
-					go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
+					go e.stepUpdateLoop(ctx, ch, execution.CreatedAt)
+				} else {
+					ch.cancel(nil)
 				}
 				e.queueIfReady(execution, sd)
 			}
@@ -492,16 +580,44 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 // This is important to avoid data races, and any accesses of `executionState` by any other
 // goroutine should happen via a `stepRequest` message containing a copy of the latest
 // `executionState`.
-func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpdateCh chan store.WorkflowExecutionStep, workflowCreatedAt *time.Time) {
+//
+// The loop also enforces the deadline of the execution, and finishes it once it is canceled.
+func (e *Engine) stepUpdateLoop(ctx context.Context, stepUpdateCh stepUpdateChannel, workflowCreatedAt *time.Time) {
 	defer e.wg.Done()
+	defer close(stepUpdateCh.done)
+	executionID := stepUpdateCh.executionID
 	lggr := e.logger.With(eIDKey, executionID)
 	e.logger.Debugf("running stepUpdateLoop for execution %s", executionID)
+
+	var deadline <-chan time.Time
+	if workflowCreatedAt != nil {
+		timer := e.clock.NewTimer(e.maxExecutionDuration - e.clock.Since(*workflowCreatedAt))
+		defer timer.Stop()
+		deadline = timer.Chan()
+	}
 	for {
 		select {
 		case <-ctx.Done():
 			lggr.Debug("shutting down stepUpdateLoop")
 			return
-		case stepUpdate, open := <-stepUpdateCh:
+		case <-deadline:
+			lggr.Info("execution timed out; interrupting its steps")
+			stepUpdateCh.cancel(errExecutionTimedOut)
+			if err := e.finishExecution(ctx, executionID, store.StatusTimeout); err != nil {
+				lggr.Errorf("failed to time out execution: %s", err)
+			}
+			return
+		case <-stepUpdateCh.ctx.Done():
+			if ctx.Err() != nil || !errors.Is(context.Cause(stepUpdateCh.ctx), errExecutionCancelled) {
+				lggr.Debug("execution ended, shutting down stepUpdateLoop")
+				return
+			}
+			lggr.Info("execution cancelled")
+			if err := e.finishExecution(ctx, executionID, store.StatusCancelled); err != nil {
+				lggr.Errorf("failed to cancel execution: %s", err)
+			}
+			return
+		case stepUpdate, open := <-stepUpdateCh.ch:
 			if !open {
 				lggr.Debug("stepUpdate channel closed, shutting down stepUpdateLoop")
 				return
@@ -566,18 +682,16 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 		return err
 	}
 
-	ch := make(chan store.WorkflowExecutionStep)
-	added := e.stepUpdatesChMap.add(executionID, stepUpdateChannel{
-		ch:          ch,
-		executionID: executionID,
-	})
+	ch := newStepUpdateChannel(ctx, executionID)
+	added := e.stepUpdatesChMap.add(executionID, ch)
 	if !added {
+		ch.cancel(nil)
 		// skip this execution since there's already a stepUpdateLoop running for the execution ID
 		lggr.Debugf("won't start execution for execution %s, execution was already started", executionID)
 		return nil
 	}
 	e.wg.Add(1)
-	go e.stepUpdateLoop(ctx, executionID, ch, dbWex.CreatedAt)
+	go e.stepUpdateLoop(ctx, ch, dbWex.CreatedAt)
 
 	for _, td := range triggerDependents {
 		e.queueIfReady(*ec, td)
@@ -601,6 +715,11 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 		return err
 	}
 
+	// A step that is still started failed and will be retried.
+	if stepUpdate.Status == store.StatusStarted {
+		return e.retryStep(ctx, state, stepUpdate.Ref, stepUpdate.Attempts)
+	}
+
 	workflowIsFullyProcessed, status, err := e.isWorkflowFullyProcessed(ctx, state)
 	if err != nil {
 		return err
@@ -641,6 +760,39 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	return nil
 }
 
+// retryStep enqueues the step again once the backoff of its retry policy,
+// given the attempts made so far, has elapsed.
+func (e *Engine) retryStep(ctx context.Context, state store.WorkflowExecution, ref string, attempts int) error {
+	s, err := e.workflow.Vertex(ref)
+	if err != nil {
+		return err
+	}
+
+	req := stepRequest{
+		state:   copyState(state),
+		stepRef: ref,
+	}
+	backoff := s.retry.backoff(attempts)
+	e.logger.With(sRKey, ref, eIDKey, state.ExecutionID).Debugf("retrying step in %s", backoff)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		if backoff > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.clock.After(backoff):
+			}
+		}
+		select {
+		case <-ctx.Done():
+		case e.pendingStepRequests <- req:
+		}
+	}()
+	return nil
+}
+
 func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
@@ -744,11 +896,25 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	l := e.logger.With(sRKey, msg.stepRef, eIDKey, msg.state.ExecutionID)
 	cma := e.cma.With(sRKey, msg.stepRef, eIDKey, msg.state.ExecutionID)
 
+	// Steps of executions that have finished, timed out or been canceled
+	// in the meantime are no longer dispatched.
+	execCtx, ok := e.stepUpdatesChMap.ctx(msg.state.ExecutionID)
+	if !ok || execCtx.Err() != nil {
+		l.Debug("execution is no longer running; dropping step request")
+		return
+	}
+
 	l.Debug("executing on a step event")
 	stepState := &store.WorkflowExecutionStep{
 		Outputs:     store.StepOutput{},
 		ExecutionID: msg.state.ExecutionID,
 		Ref:         msg.stepRef,
+		Attempts:    1,
+	}
+	// The attempts of a retried step are carried over from its persisted
+	// state, so that they are honoured across restarts.
+	if prev, ok := msg.state.Steps[msg.stepRef]; ok {
+		stepState.Attempts = prev.Attempts + 1
 	}
 
 	// TODO ks-462 inputs
@@ -756,7 +922,12 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	if err != nil {
 		return
 	}
-	inputs, outputs, err := e.executeStep(ctx, msg)
+	inputs, outputs, err := e.executeStep(execCtx, msg, stepState.Attempts)
+	if execCtx.Err() != nil {
+		l.Infof("execution ended while executing step: %s", context.Cause(execCtx))
+		return
+	}
+	var retryErr *retryStepError
 	var stepStatus string
 	switch {
 	case errors.Is(capabilities.ErrStopExecution, err):
@@ -767,6 +938,23 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 			l.Errorf("failed to send custom message with msg: %s", lmsg)
 		}
 		stepStatus = store.StatusCompletedEarlyExit
+	case errors.As(err, &retryErr):
+		lmsg := fmt.Sprintf("error executing step request on attempt %d, retrying: %s", stepState.Attempts, err)
+		l.Warn(lmsg)
+		cmErr := cma.SendLogAsCustomMessage(lmsg)
+		if cmErr != nil {
+			l.Errorf("failed to send custom message with msg: %s", lmsg)
+		}
+		// The step stays started until the retry is picked up by handleStepUpdate.
+		stepStatus = store.StatusStarted
+	case errors.Is(err, errStepTimedOut):
+		lmsg := fmt.Sprintf("step timed out: %s", err)
+		l.Error(lmsg)
+		cmErr := cma.SendLogAsCustomMessage(lmsg)
+		if cmErr != nil {
+			l.Errorf("failed to send custom message with msg: %s", lmsg)
+		}
+		stepStatus = store.StatusTimeout
 	case err != nil:
 		lmsg := "step executed successfully with a termination"
 		l.Errorf("error executing step request: %s", err)
@@ -820,14 +1008,14 @@ func merge(baseConfig *values.Map, overrideConfig *values.Map) *values.Map {
 	return m
 }
 
-func (e *Engine) configForStep(ctx context.Context, executionID string, step *step) (*values.Map, error) {
-	ID := step.info.ID
+func (e *Engine) configForCapability(ctx context.Context, executionID string, info capabilities.CapabilityInfo, config *values.Map) (*values.Map, error) {
+	ID := info.ID
 
 	// If the capability info is missing a DON, then
 	// the capability is local, and we should use the localNode's DON ID.
 	var donID uint32
-	if !step.info.IsLocal {
-		donID = step.info.DON.ID
+	if !info.IsLocal {
+		donID = info.DON.ID
 	} else {
 		donID = e.localNode.WorkflowDON.ID
 	}
@@ -835,21 +1023,34 @@ func (e *Engine) configForStep(ctx context.Context, executionID string, step *st
 	capConfig, err := e.registry.ConfigForCapability(ctx, ID, donID)
 	if err != nil {
 		e.logger.Warnw(fmt.Sprintf("could not retrieve config from remote registry: %s", err), "executionID", executionID, "capabilityID", ID)
-		return step.config, nil
+		return config, nil
 	}
 
 	if capConfig.DefaultConfig == nil {
-		return step.config, nil
+		return config, nil
 	}
 
 	// Merge the configs with registry config overriding the step config.  This is because
 	// some config fields are sensitive and could affect the safe running of the capability,
 	// so we avoid user provided values by overriding them with config from the capabilities registry.
-	return merge(step.config, capConfig.DefaultConfig), nil
+	return merge(config, capConfig.DefaultConfig), nil
 }
 
+// retryStepError is returned by executeStep when the step failed and is
+// retried according to its retry policy.
+type retryStepError struct {
+	err error
+}
+
+func (r *retryStepError) Error() string { return r.err.Error() }
+
+func (r *retryStepError) Unwrap() error { return r.err }
+
 // executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map, values.Value, error) {
+//
+// If the capability fails, the step is retried when its retry policy allows another
+// attempt, or else routed to its fallback capability, if any.
+func (e *Engine) executeStep(ctx context.Context, msg stepRequest, attempts int) (*values.Map, values.Value, error) {
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
 		return nil, nil, err
@@ -872,7 +1073,7 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 		return nil, nil, err
 	}
 
-	config, err := e.configForStep(ctx, msg.state.ExecutionID, step)
+	config, err := e.configForCapability(ctx, msg.state.ExecutionID, step.info, step.config)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -892,14 +1093,58 @@ func (e *Engine) executeStep(ctx context.Context, msg stepRequest) (*values.Map,
 	}
 
 	e.metrics.incrementCapabilityInvocationCounter(ctx)
-	output, err := step.capability.Execute(ctx, tr)
+	output, err := executeWithTimeout(ctx, step.capability, tr, step.timeout)
 	if err != nil {
+		switch {
+		case errors.Is(capabilities.ErrStopExecution, err):
+		case step.retry.shouldRetry(attempts, err):
+			return inputsMap, nil, &retryStepError{err: err}
+		case step.fallback != nil:
+			return e.executeFallback(ctx, msg, step, tr, err)
+		}
 		return inputsMap, nil, err
 	}
 
 	return inputsMap, output.Value, err
 }
 
+// executeFallback executes the fallback capability of a step that failed with stepErr,
+// using the same inputs as the step.
+func (e *Engine) executeFallback(ctx context.Context, msg stepRequest, step *step, tr capabilities.CapabilityRequest, stepErr error) (*values.Map, values.Value, error) {
+	fallback := step.fallback
+	e.logger.With(sRKey, msg.stepRef, eIDKey, msg.state.ExecutionID, cIDKey, fallback.ID).
+		Warnf("step failed, routing to fallback capability: %s", stepErr)
+
+	config, err := e.configForCapability(ctx, msg.state.ExecutionID, fallback.info, fallback.config)
+	if err != nil {
+		return tr.Inputs, nil, err
+	}
+	tr.Config = config
+
+	e.metrics.incrementCapabilityInvocationCounter(ctx)
+	output, err := executeWithTimeout(ctx, fallback.capability, tr, step.timeout)
+	if err != nil {
+		return tr.Inputs, nil, fmt.Errorf("%w; fallback capability %s failed: %w", stepErr, fallback.ID, err)
+	}
+
+	return tr.Inputs, output.Value, nil
+}
+
+// executeWithTimeout executes the capability, which is interrupted once the step timeout, if any, has elapsed.
+func executeWithTimeout(ctx context.Context, capability capabilities.ExecutableCapability, req capabilities.CapabilityRequest, timeout time.Duration) (capabilities.CapabilityResponse, error) {
+	if timeout <= 0 {
+		return capability.Execute(ctx, req)
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, errStepTimedOut)
+	defer cancel()
+	resp, err := capability.Execute(ctx, req)
+	if err != nil && errors.Is(context.Cause(ctx), errStepTimedOut) {
+		return resp, fmt.Errorf("%w after %s: %w", errStepTimedOut, timeout, err)
+	}
+	return resp, err
+}
+
 func (e *Engine) deregisterTrigger(ctx context.Context, t *triggerCapability, triggerIdx int) error {
 	deregRequest := capabilities.TriggerRegistrationRequest{
 		Metadata: capabilities.RequestMetadata{
@@ -974,7 +1219,8 @@ func (e *Engine) isWorkflowFullyProcessed(ctx context.Context, state store.Workf
 	err = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
 		// If the step is not part of the state, it is a pending step
 		// so we should consider the workflow as not fully processed.
-		if _, ok := statuses[s.Ref]; !ok {
+		// The same goes for a step that is started, pending a retry.
+		if status, ok := statuses[s.Ref]; !ok || status == store.StatusStarted {
 			workflowProcessed = false
 		}
 		return nil
@@ -1018,6 +1264,9 @@ func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
 		ctx := context.Background()
+		if e.engineRegistry != nil {
+			e.engineRegistry.remove(e)
+		}
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
 		// then we'll close down any background goroutines,
@@ -1062,6 +1311,22 @@ func (e *Engine) Close() error {
 					}}
 			}
 
+			if s.fallback == nil || s.fallback.capability == nil {
+				return nil
+			}
+
+			reg.Config = s.fallback.config
+			innerErr = s.fallback.capability.UnregisterFromWorkflow(ctx, reg)
+			if innerErr != nil {
+				return &workflowError{err: innerErr,
+					reason: fmt.Sprintf("failed to unregister fallback capability from workflow: %+v", reg),
+					labels: map[string]string{
+						wIDKey: e.workflow.id,
+						sIDKey: s.fallback.ID,
+						sRKey:  s.Ref,
+					}}
+			}
+
 			return nil
 		})
 		if err != nil {
@@ -1086,6 +1351,8 @@ type Config struct {
 	Store                store.Store
 	Config               []byte
 	Binary               []byte
+	// Engines is optional, and tracks the engine while it is running.
+	Engines *EngineRegistry
 
 	// For testing purposes only
 	maxRetries          int
@@ -1183,6 +1450,7 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 		retryMs:              cfg.retryMs,
 		maxWorkerLimit:       cfg.MaxWorkerLimit,
 		clock:                cfg.clock,
+		engineRegistry:       cfg.Engines,
 	}
 
 	return engine, nil
@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// EngineRegistry keeps track of the running engines by workflow ID, so that
+// their executions can be managed through the API.
+type EngineRegistry struct {
+	store store.Store
+
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewEngineRegistry(store store.Store) *EngineRegistry {
+	return &EngineRegistry{store: store, engines: map[string]*Engine{}}
+}
+
+func (r *EngineRegistry) add(e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.engines[e.workflow.id] = e
+}
+
+func (r *EngineRegistry) remove(e *Engine) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.engines[e.workflow.id] == e {
+		delete(r.engines, e.workflow.id)
+	}
+}
+
+func (r *EngineRegistry) get(workflowID string) (*Engine, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.engines[workflowID]
+	return e, ok
+}
+
+// CancelExecution cancels a started execution and returns it. If the execution is
+// not running on this node, for instance since its workflow was deleted, it is
+// marked as cancelled so that it is no longer resumed.
+func (r *EngineRegistry) CancelExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	execution, err := r.store.Get(ctx, executionID)
+	if err != nil {
+		return execution, err
+	}
+	if execution.Status != store.StatusStarted {
+		return execution, fmt.Errorf("%w: execution %s is %s", ErrExecutionNotRunning, executionID, execution.Status)
+	}
+
+	err = ErrExecutionNotRunning
+	if e, ok := r.get(execution.WorkflowID); ok {
+		err = e.CancelExecution(ctx, executionID)
+	}
+	if errors.Is(err, ErrExecutionNotRunning) {
+		// The execution may have finished since it was fetched, so only cancel it if it is still started.
+		if err = r.store.CancelStarted(ctx, executionID); errors.Is(err, store.ErrExecutionFinished) {
+			err = fmt.Errorf("%w: %w", ErrExecutionNotRunning, err)
+		}
+	}
+	if err != nil {
+		return execution, err
+	}
+
+	return r.store.Get(ctx, executionID)
+}
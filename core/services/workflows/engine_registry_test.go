@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store/mocks"
+)
+
+func TestEngineRegistry_CancelExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		s := mocks.NewStore(t)
+		s.On("Get", mock.Anything, "<execution-ID>").Return(store.WorkflowExecution{}, store.ErrExecutionNotFound)
+
+		_, err := NewEngineRegistry(s).CancelExecution(ctx, "<execution-ID>")
+		require.ErrorIs(t, err, store.ErrExecutionNotFound)
+	})
+
+	t.Run("not running", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		s := mocks.NewStore(t)
+		s.On("Get", mock.Anything, "<execution-ID>").Return(store.WorkflowExecution{
+			ExecutionID: "<execution-ID>",
+			WorkflowID:  testWorkflowId,
+			Status:      store.StatusCompleted,
+		}, nil)
+
+		_, err := NewEngineRegistry(s).CancelExecution(ctx, "<execution-ID>")
+		require.ErrorIs(t, err, ErrExecutionNotRunning)
+	})
+
+	t.Run("without a running engine", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		s := mocks.NewStore(t)
+		s.On("Get", mock.Anything, "<execution-ID>").Return(store.WorkflowExecution{
+			ExecutionID: "<execution-ID>",
+			WorkflowID:  testWorkflowId,
+			Status:      store.StatusStarted,
+		}, nil).Once()
+		s.On("CancelStarted", mock.Anything, "<execution-ID>").Return(nil).Once()
+		s.On("Get", mock.Anything, "<execution-ID>").Return(store.WorkflowExecution{
+			ExecutionID: "<execution-ID>",
+			WorkflowID:  testWorkflowId,
+			Status:      store.StatusCancelled,
+		}, nil).Once()
+
+		execution, err := NewEngineRegistry(s).CancelExecution(ctx, "<execution-ID>")
+		require.NoError(t, err)
+		assert.Equal(t, store.StatusCancelled, execution.Status)
+	})
+	t.Run("finished before being cancelled", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		s := mocks.NewStore(t)
+		s.On("Get", mock.Anything, "<execution-ID>").Return(store.WorkflowExecution{
+			ExecutionID: "<execution-ID>",
+			WorkflowID:  testWorkflowId,
+			Status:      store.StatusStarted,
+		}, nil).Once()
+		s.On("CancelStarted", mock.Anything, "<execution-ID>").Return(store.ErrExecutionFinished).Once()
+
+		_, err := NewEngineRegistry(s).CancelExecution(ctx, "<execution-ID>")
+		require.ErrorIs(t, err, ErrExecutionNotRunning)
+		require.ErrorIs(t, err, store.ErrExecutionFinished)
+	})
+}
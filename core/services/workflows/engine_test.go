@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1534,3 +1535,362 @@ func TestEngine_CustomComputePropagatesBreaks(t *testing.T) {
 
 	assert.Equal(t, state.Status, store.StatusCompletedEarlyExit)
 }
+
+const retriedStepWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      retry_policy:
+        max_attempts: 3
+        backoff: 0s
+        retryable_errors: ["transient"]
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    ref: "write_polygon"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+      on_error:
+        id: "write_ethereum-testnet-sepolia@1.0.0"
+        config:
+          address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"
+`
+
+// mockFlakyConsensus returns a consensus capability failing with err the first failures
+// times it is executed, and counts its executions.
+func mockFlakyConsensus(failures int32, err error) (*mockCapability, *atomic.Int32) {
+	var executions atomic.Int32
+	consensus := mockConsensus("")
+	succeed := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if executions.Add(1) <= failures {
+			return capabilities.CapabilityResponse{}, err
+		}
+		return succeed(req)
+	}
+	return consensus, &executions
+}
+
+func mockFailingTarget(id string) *mockCapability {
+	return newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			id,
+			capabilities.CapabilityTypeTarget,
+			"a failing write capability",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			return capabilities.CapabilityResponse{}, errors.New("fatal write error")
+		},
+	)
+}
+
+func TestEngine_RetriesFailedSteps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retryable error", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+		trigger, _ := mockTrigger(t)
+		consensus, executions := mockFlakyConsensus(2, errors.New("transient consensus error"))
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, consensus))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("write_ethereum-testnet-sepolia@1.0.0")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, retriedStepWorkflow)
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusCompleted, state.Status)
+		assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+		assert.Equal(t, 3, state.Steps["evm_median"].Attempts)
+		assert.Equal(t, int32(3), executions.Load())
+		assert.Equal(t, 1, state.Steps["write_polygon"].Attempts)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+		trigger, _ := mockTrigger(t)
+		consensus, executions := mockFlakyConsensus(3, errors.New("transient consensus error"))
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, consensus))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("write_ethereum-testnet-sepolia@1.0.0")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, retriedStepWorkflow)
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusErrored, state.Status)
+		assert.Equal(t, store.StatusErrored, state.Steps["evm_median"].Status)
+		assert.Equal(t, 3, state.Steps["evm_median"].Attempts)
+		assert.Equal(t, int32(3), executions.Load())
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		ctx := testutils.Context(t)
+		reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+		trigger, _ := mockTrigger(t)
+		consensus, executions := mockFlakyConsensus(1, errors.New("fatal consensus error"))
+		require.NoError(t, reg.Add(ctx, trigger))
+		require.NoError(t, reg.Add(ctx, consensus))
+		require.NoError(t, reg.Add(ctx, mockTarget("")))
+		require.NoError(t, reg.Add(ctx, mockTarget("write_ethereum-testnet-sepolia@1.0.0")))
+
+		eng, hooks := newTestEngineWithYAMLSpec(t, reg, retriedStepWorkflow)
+		servicetest.Run(t, eng)
+
+		eid := getExecutionId(t, eng, hooks)
+		state, err := eng.executionStates.Get(ctx, eid)
+		require.NoError(t, err)
+
+		assert.Equal(t, store.StatusErrored, state.Status)
+		assert.Equal(t, 1, state.Steps["evm_median"].Attempts)
+		assert.Equal(t, int32(1), executions.Load())
+	})
+}
+
+func TestEngine_RoutesFailedStepsToFallback(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	fallback := mockTarget("write_ethereum-testnet-sepolia@1.0.0")
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockFailingTarget("write_polygon-testnet-mumbai@1.0.0")))
+	require.NoError(t, reg.Add(ctx, fallback))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, retriedStepWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["write_polygon"].Status)
+
+	resp := <-fallback.response
+	assert.Equal(t, resp.Value, state.Steps["write_polygon"].Outputs.Value)
+}
+
+func TestEngine_ResumesRetriedSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	consensus, executions := mockFlakyConsensus(1, errors.New("transient consensus error"))
+	require.NoError(t, reg.Add(ctx, mockNoopTrigger(t)))
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("write_ethereum-testnet-sepolia@1.0.0")))
+
+	resp, err := values.NewMap(map[string]any{
+		"123": decimal.NewFromFloat(1.00),
+	})
+	require.NoError(t, err)
+	dbstore := newTestDBStore(t, clockwork.NewFakeClock())
+	// the consensus step failed twice before the node restarted,
+	// so it has one attempt left
+	_, err = dbstore.Add(ctx, &store.WorkflowExecution{
+		Steps: map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {
+				Outputs:     store.StepOutput{Value: resp},
+				Status:      store.StatusCompleted,
+				ExecutionID: "<execution-ID>",
+				Ref:         workflows.KeywordTrigger,
+			},
+			"evm_median": {
+				Outputs:     store.StepOutput{Err: errors.New("transient consensus error")},
+				Status:      store.StatusStarted,
+				ExecutionID: "<execution-ID>",
+				Ref:         "evm_median",
+				Attempts:    2,
+			},
+		},
+		WorkflowID:  testWorkflowId,
+		ExecutionID: "<execution-ID>",
+		Status:      store.StatusStarted,
+	})
+	require.NoError(t, err)
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, retriedStepWorkflow, func(c *Config) { c.Store = dbstore })
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusErrored, state.Status)
+	assert.Equal(t, 3, state.Steps["evm_median"].Attempts)
+	assert.ErrorContains(t, state.Steps["evm_median"].Outputs.Err, "transient consensus error")
+	assert.Equal(t, int32(1), executions.Load())
+}
+
+const slowStepWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+      step_timeout: 100ms
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`
+
+// mockBlockingCapability is a consensus capability which blocks until its request is interrupted,
+// and sends the ID of the execution on started once it is executing.
+type mockBlockingCapability struct {
+	*mockCapability
+	started chan string
+}
+
+func mockBlockingConsensus() *mockBlockingCapability {
+	return &mockBlockingCapability{
+		mockCapability: mockConsensus(""),
+		started:        make(chan string, 10),
+	}
+}
+
+func (m *mockBlockingCapability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	m.started <- req.Metadata.WorkflowExecutionID
+	<-ctx.Done()
+	return capabilities.CapabilityResponse{}, ctx.Err()
+}
+
+func TestEngine_TimesOutSlowSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockBlockingConsensus()))
+	require.NoError(t, reg.Add(ctx, target))
+
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, slowStepWorkflow)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusTimeout, state.Status)
+	assert.Equal(t, store.StatusTimeout, state.Steps["evm_median"].Status)
+	assert.ErrorContains(t, state.Steps["evm_median"].Outputs.Err, "step timed out after 100ms")
+	assert.Empty(t, target.response)
+}
+
+func TestEngine_TimesOutSlowExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	consensus := mockBlockingConsensus()
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, target))
+
+	clock := clockwork.NewFakeClock()
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.clock = clock
+		c.MaxExecutionDuration = time.Minute
+	})
+	servicetest.Run(t, eng)
+
+	started := <-consensus.started
+	// the deadline timer may not have been created yet, so keep advancing until it fires
+	var eid string
+	for eid == "" {
+		clock.Advance(time.Minute)
+		select {
+		case <-hooks.initFailed:
+			t.FailNow()
+		case eid = <-hooks.executionFinished:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, started, eid)
+
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusTimeout, state.Status)
+	assert.Empty(t, target.response)
+}
+
+func TestEngine_CancelsExecutions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	consensus := mockBlockingConsensus()
+	target := mockTarget("")
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, target))
+
+	dbstore := newTestDBStore(t, clockwork.NewFakeClock())
+	engines := NewEngineRegistry(dbstore)
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = dbstore
+		c.Engines = engines
+	})
+	servicetest.Run(t, eng)
+
+	started := <-consensus.started
+	execution, err := engines.CancelExecution(ctx, started)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCancelled, execution.Status)
+	assert.NotNil(t, execution.FinishedAt)
+
+	eid := getExecutionId(t, eng, hooks)
+	assert.Equal(t, started, eid)
+	assert.Empty(t, target.response)
+
+	_, err = engines.CancelExecution(ctx, eid)
+	require.ErrorIs(t, err, ErrExecutionNotRunning)
+	assert.ErrorIs(t, eng.CancelExecution(ctx, eid), ErrExecutionNotRunning)
+}
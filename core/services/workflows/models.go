@@ -3,9 +3,13 @@ package workflows
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/dominikbraun/graph"
+	"github.com/go-viper/mapstructure/v2"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
 
@@ -85,6 +89,145 @@ type step struct {
 	capability capabilities.ExecutableCapability
 	info       capabilities.CapabilityInfo
 	config     *values.Map
+
+	// retry, fallback and timeout are declared in the step config under the retryPolicyKey,
+	// onErrorKey and stepTimeoutKey keys, which are not passed on to the capability.
+	retry    *retryPolicy
+	fallback *fallbackStep
+	timeout  time.Duration
+}
+
+const (
+	retryPolicyKey = "retry_policy"
+	onErrorKey     = "on_error"
+	stepTimeoutKey = "step_timeout"
+)
+
+// retryPolicy describes how often a failed step is retried before it errors.
+type retryPolicy struct {
+	// MaxAttempts is the maximum number of times the step is executed, including the first attempt.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Backoff is the delay before the first retry, which doubles with every subsequent retry.
+	Backoff time.Duration `mapstructure:"backoff"`
+	// MaxBackoff caps the delay between retries, if set.
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+	// RetryableErrors are substrings of the errors that are retried. If empty, all errors are retried.
+	RetryableErrors []string `mapstructure:"retryable_errors"`
+}
+
+// shouldRetry returns whether a step that failed with err after the given
+// number of attempts should be executed again.
+func (r *retryPolicy) shouldRetry(attempts int, err error) bool {
+	if r == nil || err == nil || attempts >= r.MaxAttempts {
+		return false
+	}
+	if len(r.RetryableErrors) == 0 {
+		return true
+	}
+	for _, retryable := range r.RetryableErrors {
+		if strings.Contains(err.Error(), retryable) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the step is executed again after the given number of attempts.
+func (r *retryPolicy) backoff(attempts int) time.Duration {
+	if r == nil {
+		return 0
+	}
+	d := r.Backoff
+	for i := 1; i < attempts; i++ {
+		if d > math.MaxInt64/2 || (r.MaxBackoff > 0 && d >= r.MaxBackoff) {
+			break
+		}
+		d *= 2
+	}
+	if r.MaxBackoff > 0 && d > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return d
+}
+
+// fallbackStep is an alternative capability a step is routed to, with the
+// same inputs, once the step has failed and will not be retried.
+type fallbackStep struct {
+	ID     string         `mapstructure:"id"`
+	Config map[string]any `mapstructure:"config"`
+
+	capability capabilities.ExecutableCapability
+	info       capabilities.CapabilityInfo
+	config     *values.Map
+}
+
+// parseStepPolicies removes the retry policy, fallback and timeout from the
+// config of the step and parses them.
+func parseStepPolicies(s *step) error {
+	_, hasRetry := s.Config[retryPolicyKey]
+	_, hasFallback := s.Config[onErrorKey]
+	_, hasTimeout := s.Config[stepTimeoutKey]
+	if !hasRetry && !hasFallback && !hasTimeout {
+		return nil
+	}
+
+	config := make(map[string]any, len(s.Config))
+	for k, v := range s.Config {
+		config[k] = v
+	}
+	s.Config = config
+
+	if hasRetry {
+		s.retry = &retryPolicy{}
+		if err := decodeStepPolicy(config[retryPolicyKey], s.retry); err != nil {
+			return fmt.Errorf("invalid %s of step %s: %w", retryPolicyKey, s.Ref, err)
+		}
+		if s.retry.MaxAttempts < 1 {
+			return fmt.Errorf("invalid %s of step %s: max_attempts must be at least 1", retryPolicyKey, s.Ref)
+		}
+		if s.retry.Backoff < 0 || s.retry.MaxBackoff < 0 {
+			return fmt.Errorf("invalid %s of step %s: backoff must not be negative", retryPolicyKey, s.Ref)
+		}
+		delete(config, retryPolicyKey)
+	}
+
+	if hasFallback {
+		s.fallback = &fallbackStep{}
+		if err := decodeStepPolicy(config[onErrorKey], s.fallback); err != nil {
+			return fmt.Errorf("invalid %s of step %s: %w", onErrorKey, s.Ref, err)
+		}
+		if s.fallback.ID == "" {
+			return fmt.Errorf("invalid %s of step %s: id is required", onErrorKey, s.Ref)
+		}
+		if s.fallback.Config == nil {
+			s.fallback.Config = map[string]any{}
+		}
+		delete(config, onErrorKey)
+	}
+
+	if hasTimeout {
+		if err := decodeStepPolicy(config[stepTimeoutKey], &s.timeout); err != nil {
+			return fmt.Errorf("invalid %s of step %s: %w", stepTimeoutKey, s.Ref, err)
+		}
+		if s.timeout <= 0 {
+			return fmt.Errorf("invalid %s of step %s: must be positive", stepTimeoutKey, s.Ref)
+		}
+		delete(config, stepTimeoutKey)
+	}
+	return nil
+}
+
+func decodeStepPolicy(input any, to any) error {
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           to,
+	})
+	if err != nil {
+		return err
+	}
+	return d.Decode(input)
 }
 
 type triggerCapability struct {
@@ -142,7 +285,12 @@ func createWorkflow(wf2 *workflows.DependencyGraph) (*workflow, error) {
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to retrieve vertex for %s: %w", vertexRef, innerErr)
 		}
-		innerErr = g.AddVertex(&step{Vertex: *v})
+		s := &step{Vertex: *v}
+		innerErr = parseStepPolicies(s)
+		if innerErr != nil {
+			return nil, innerErr
+		}
+		innerErr = g.AddVertex(s)
 		if innerErr != nil {
 			return nil, fmt.Errorf("failed to add vertex to executable workflow %s: %w", vertexRef, innerErr)
 		}
@@ -1,7 +1,10 @@
 package workflows
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -333,3 +336,152 @@ func TestParsesIntsCorrectly(t *testing.T) {
 
 	assert.Equal(t, int64(3600), n.Config["aggregation_config"].(map[string]any)["0x1111111111111111111100000000000000000000000000000000000000000000"].(map[string]any)["heartbeat"])
 }
+
+func TestParse_StepPolicies(t *testing.T) {
+	t.Parallel()
+	const spec = `
+triggers:
+  - id: "a-trigger@1.0.0"
+    config: {}
+
+targets:
+  - id: "a-target@1.0.0"
+    ref: "a-target"
+    inputs:
+      trigger_output: $(trigger.outputs)
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+%s
+`
+	testCases := []struct {
+		name     string
+		policies string
+		retry    *retryPolicy
+		fallback *fallbackStep
+		timeout  time.Duration
+		errMsg   string
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name: "retry policy and fallback",
+			policies: `
+      retry_policy:
+        max_attempts: 3
+        backoff: 1s
+        max_backoff: 10s
+        retryable_errors: ["timeout"]
+      on_error:
+        id: "another-target@1.0.0"
+        config:
+          address: "0x54e220867af6683aE6DcBF535B4f952cB5116510"`,
+			retry: &retryPolicy{MaxAttempts: 3, Backoff: time.Second, MaxBackoff: 10 * time.Second, RetryableErrors: []string{"timeout"}},
+			fallback: &fallbackStep{ID: "another-target@1.0.0", Config: map[string]any{
+				"address": "0x54e220867af6683aE6DcBF535B4f952cB5116510",
+			}},
+		},
+		{
+			name: "fallback without config",
+			policies: `
+      on_error:
+        id: "another-target@1.0.0"`,
+			fallback: &fallbackStep{ID: "another-target@1.0.0", Config: map[string]any{}},
+		},
+		{
+			name: "step timeout",
+			policies: `
+      step_timeout: 30s`,
+			timeout: 30 * time.Second,
+		},
+		{
+			name: "non-positive step timeout",
+			policies: `
+      step_timeout: 0s`,
+			errMsg: "invalid step_timeout of step a-target: must be positive",
+		},
+		{
+			name: "unknown retry policy field",
+			policies: `
+      retry_policy:
+        max_attempts: 3
+        jitter: 1s`,
+			errMsg: "invalid retry_policy of step a-target",
+		},
+		{
+			name: "no attempts",
+			policies: `
+      retry_policy:
+        backoff: 1s`,
+			errMsg: "max_attempts must be at least 1",
+		},
+		{
+			name: "fallback without id",
+			policies: `
+      on_error:
+        config: {}`,
+			errMsg: "id is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sdkSpec, err := (&job.WorkflowSpec{
+				Workflow: fmt.Sprintf(spec, tc.policies),
+				SpecType: job.YamlSpec,
+			}).SDKSpec(testutils.Context(t))
+			require.NoError(t, err)
+
+			wf, err := Parse(sdkSpec)
+			if tc.errMsg != "" {
+				assert.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			s, err := wf.Vertex("a-target")
+			require.NoError(t, err)
+			assert.Equal(t, tc.retry, s.retry)
+			assert.Equal(t, tc.fallback, s.fallback)
+			assert.Equal(t, tc.timeout, s.timeout)
+			// the policies are not part of the capability config
+			assert.Equal(t, map[string]any{"address": "0x3F3554832c636721F1fD1822Ccca0354576741Ef"}, s.Config)
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	timeout := errors.New("request timeout")
+	reverted := errors.New("transaction reverted")
+
+	t.Run("shouldRetry", func(t *testing.T) {
+		var none *retryPolicy
+		assert.False(t, none.shouldRetry(1, timeout))
+
+		all := &retryPolicy{MaxAttempts: 3}
+		assert.True(t, all.shouldRetry(1, timeout))
+		assert.True(t, all.shouldRetry(2, reverted))
+		assert.False(t, all.shouldRetry(3, timeout))
+		assert.False(t, all.shouldRetry(1, nil))
+
+		some := &retryPolicy{MaxAttempts: 3, RetryableErrors: []string{"timeout"}}
+		assert.True(t, some.shouldRetry(1, timeout))
+		assert.False(t, some.shouldRetry(1, reverted))
+	})
+
+	t.Run("backoff", func(t *testing.T) {
+		r := &retryPolicy{MaxAttempts: 100, Backoff: time.Second}
+		assert.Equal(t, time.Second, r.backoff(1))
+		assert.Equal(t, 2*time.Second, r.backoff(2))
+		assert.Equal(t, 4*time.Second, r.backoff(3))
+		assert.Positive(t, r.backoff(100))
+
+		r.MaxBackoff = 3 * time.Second
+		assert.Equal(t, 2*time.Second, r.backoff(2))
+		assert.Equal(t, 3*time.Second, r.backoff(3))
+		assert.Equal(t, 3*time.Second, r.backoff(100))
+	})
+}
@@ -0,0 +1,391 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// SimulatedTriggerEvent is an event emitted by a trigger of a simulated workflow.
+type SimulatedTriggerEvent struct {
+	// TriggerID is the ID of the trigger capability emitting the event. It defaults
+	// to the first trigger of the workflow.
+	TriggerID string `json:"triggerID"`
+	// ID identifies the event, and thereby its execution. It defaults to the index of the event.
+	ID      string         `json:"id"`
+	Outputs map[string]any `json:"outputs"`
+}
+
+// CapabilityFixture stubs a capability of a simulated workflow, which returns
+// Outputs, or fails with Error if it is set.
+type CapabilityFixture struct {
+	Outputs map[string]any `json:"outputs"`
+	Error   string         `json:"error"`
+}
+
+// ParseSimulatedTriggerEvents parses a JSON array of events.
+func ParseSimulatedTriggerEvents(b []byte) ([]SimulatedTriggerEvent, error) {
+	var events []SimulatedTriggerEvent
+	if err := decodeSimulationJSON(b, &events); err != nil {
+		return nil, fmt.Errorf("failed to parse events: %w", err)
+	}
+	for i, ev := range events {
+		outputs, err := jsonserializable.ReinterpretJSONNumbers(ev.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse events: event %d: %w", i, err)
+		}
+		events[i].Outputs, _ = outputs.(map[string]any)
+	}
+	return events, nil
+}
+
+// ParseCapabilityFixtures parses a JSON object of fixtures by capability ID. Empty input results in no fixtures.
+func ParseCapabilityFixtures(b []byte) (map[string]CapabilityFixture, error) {
+	fixtures := map[string]CapabilityFixture{}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return fixtures, nil
+	}
+	if err := decodeSimulationJSON(b, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse fixtures: %w", err)
+	}
+	for id, fixture := range fixtures {
+		if fixture.Outputs == nil {
+			continue
+		}
+		outputs, err := jsonserializable.ReinterpretJSONNumbers(fixture.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse fixtures: capability %s: %w", id, err)
+		}
+		fixture.Outputs, _ = outputs.(map[string]any)
+		fixtures[id] = fixture
+	}
+	return fixtures, nil
+}
+
+// decodeSimulationJSON decodes numbers the same way as the JSON of pipeline task params.
+func decodeSimulationJSON(b []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	d.DisallowUnknownFields()
+	return d.Decode(v)
+}
+
+// SimulationConfig describes a simulation of a workflow.
+type SimulationConfig struct {
+	Lggr logger.Logger
+	Spec *job.WorkflowSpec
+	// Events are emitted by the triggers of the workflow, each starting an execution.
+	Events []SimulatedTriggerEvent
+	// Fixtures stub the capabilities of the workflow by ID. Capabilities without a fixture
+	// return their inputs as outputs, except for custom compute which runs the workflow binary.
+	Fixtures map[string]CapabilityFixture
+}
+
+// SimulationResult is the outcome of a simulation.
+type SimulationResult struct {
+	// Executions are the executions of the events, in the order of the events.
+	Executions []store.WorkflowExecution
+	// Trace holds the updates of the steps of the executions, in the order the engine made them.
+	Trace []store.WorkflowExecutionStep
+}
+
+// Simulate runs the workflow in an engine against the events, with its capabilities
+// stubbed and its executions kept in memory, so that it requires neither a network
+// nor a database. It returns once all executions have finished, or ctx is done.
+func Simulate(ctx context.Context, cfg SimulationConfig) (SimulationResult, error) {
+	var result SimulationResult
+	sdkSpec, err := cfg.Spec.SDKSpec(ctx)
+	if err != nil {
+		return result, err
+	}
+	binary, err := cfg.Spec.RawSpec(ctx)
+	if err != nil {
+		return result, err
+	}
+	wf, err := Parse(sdkSpec)
+	if err != nil {
+		return result, err
+	}
+	if len(sdkSpec.Triggers) == 0 {
+		return result, errors.New("workflow has no triggers")
+	}
+
+	registry := coreCap.NewRegistry(cfg.Lggr)
+	registry.SetLocalRegistry(simulatedNode{})
+
+	triggerEvents := map[string][]capabilities.TriggerResponse{}
+	for _, t := range sdkSpec.Triggers {
+		triggerEvents[t.ID] = nil
+	}
+	executionIDs := make([]string, len(cfg.Events))
+	seen := map[string]bool{}
+	for i, ev := range cfg.Events {
+		if ev.TriggerID == "" {
+			ev.TriggerID = sdkSpec.Triggers[0].ID
+		}
+		if _, ok := triggerEvents[ev.TriggerID]; !ok {
+			return result, fmt.Errorf("event %d: workflow has no trigger %s", i, ev.TriggerID)
+		}
+		if ev.ID == "" {
+			ev.ID = strconv.Itoa(i)
+		}
+		if seen[ev.ID] {
+			return result, fmt.Errorf("event %d: duplicate event ID %s", i, ev.ID)
+		}
+		seen[ev.ID] = true
+
+		outputs, err := values.NewMap(ev.Outputs)
+		if err != nil {
+			return result, fmt.Errorf("event %d: invalid outputs: %w", i, err)
+		}
+		triggerEvents[ev.TriggerID] = append(triggerEvents[ev.TriggerID], capabilities.TriggerResponse{
+			Event: capabilities.TriggerEvent{TriggerType: ev.TriggerID, ID: ev.ID, Outputs: outputs},
+		})
+		if executionIDs[i], err = generateExecutionID(cfg.Spec.WorkflowID, ev.ID); err != nil {
+			return result, err
+		}
+	}
+	for id, events := range triggerEvents {
+		info, err := capabilities.NewCapabilityInfo(id, capabilities.CapabilityTypeTrigger, "simulated trigger")
+		if err != nil {
+			return result, err
+		}
+		if err = registry.Add(ctx, &simulatedTrigger{CapabilityInfo: info, events: events}); err != nil {
+			return result, err
+		}
+	}
+
+	var computes []*compute.Compute
+	defer func() {
+		for _, c := range computes {
+			_ = c.Close()
+		}
+	}()
+	err = wf.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if s.Ref == workflows.KeywordTrigger {
+			return nil
+		}
+		ids := []string{s.ID}
+		if s.fallback != nil {
+			ids = append(ids, s.fallback.ID)
+		}
+		for _, id := range ids {
+			if _, err := registry.Get(ctx, id); err == nil {
+				continue
+			}
+			fixture, ok := cfg.Fixtures[id]
+			if !ok && id == compute.CapabilityIDCompute {
+				// custom compute runs the workflow binary, and registers itself once started
+				c := compute.NewAction(webapi.ServiceConfig{}, cfg.Lggr, registry, nil, uuid.NewString)
+				if err := c.Start(ctx); err != nil {
+					return err
+				}
+				computes = append(computes, c)
+				continue
+			}
+			info, err := capabilities.NewCapabilityInfo(id, s.CapabilityType, "simulated capability")
+			if err != nil {
+				return fmt.Errorf("cannot simulate capability of step %s: %w", s.Ref, err)
+			}
+			if err := registry.Add(ctx, newSimulatedCapability(info, fixture, ok)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	ts := &tracingStore{Store: store.NewMemStore(clockwork.NewRealClock())}
+	initialized := make(chan bool, 1)
+	finished := make(chan string, len(cfg.Events))
+	engine, err := NewEngine(Config{
+		Workflow:             sdkSpec,
+		WorkflowID:           cfg.Spec.WorkflowID,
+		WorkflowOwner:        cfg.Spec.WorkflowOwner,
+		WorkflowName:         cfg.Spec.WorkflowName,
+		Lggr:                 cfg.Lggr,
+		Registry:             registry,
+		Store:                ts,
+		Config:               []byte(cfg.Spec.Config),
+		Binary:               binary,
+		MaxExecutionDuration: cfg.Spec.MaxExecutionDuration.Duration(),
+		maxRetries:           1,
+		retryMs:              100,
+		afterInit:            func(success bool) { initialized <- success },
+		onExecutionFinished:  func(executionID string) { finished <- executionID },
+	})
+	if err != nil {
+		return result, err
+	}
+	if err = engine.Start(ctx); err != nil {
+		return result, err
+	}
+	defer engine.Close()
+
+	select {
+	case <-ctx.Done():
+		return result, context.Cause(ctx)
+	case ok := <-initialized:
+		if !ok {
+			return result, errors.New("failed to initialize workflow engine, see the logs for details")
+		}
+	}
+	for done := 0; done < len(cfg.Events) && err == nil; done++ {
+		select {
+		case <-ctx.Done():
+			err = fmt.Errorf("simulation interrupted before all executions finished: %w", context.Cause(ctx))
+		case <-finished:
+		}
+	}
+
+	for _, id := range executionIDs {
+		execution, getErr := ts.Get(ctx, id)
+		if errors.Is(getErr, store.ErrExecutionNotFound) {
+			continue
+		} else if getErr != nil {
+			return result, getErr
+		}
+		result.Executions = append(result.Executions, execution)
+	}
+	result.Trace = ts.steps()
+	return result, err
+}
+
+// simulatedNode is the local node of a simulation, the single member of its DON.
+type simulatedNode struct{}
+
+func (simulatedNode) LocalNode(context.Context) (capabilities.Node, error) {
+	peerID := p2ptypes.PeerID{}
+	return capabilities.Node{
+		PeerID: &peerID,
+		WorkflowDON: capabilities.DON{
+			ID:      1,
+			Members: []p2ptypes.PeerID{peerID},
+		},
+	}, nil
+}
+
+func (simulatedNode) ConfigForCapability(context.Context, string, uint32) (registrysyncer.CapabilityConfiguration, error) {
+	return registrysyncer.CapabilityConfiguration{}, nil
+}
+
+// simulatedTrigger emits its events once registered.
+type simulatedTrigger struct {
+	capabilities.CapabilityInfo
+	events []capabilities.TriggerResponse
+
+	once sync.Once
+}
+
+var _ capabilities.TriggerCapability = (*simulatedTrigger)(nil)
+
+func (t *simulatedTrigger) RegisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	ch := make(chan capabilities.TriggerResponse, len(t.events))
+	// a trigger declared more than once by the workflow emits its events only once
+	t.once.Do(func() {
+		for _, ev := range t.events {
+			ch <- ev
+		}
+	})
+	return ch, nil
+}
+
+func (t *simulatedTrigger) UnregisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) error {
+	return nil
+}
+
+// simulatedCapability responds according to its fixture, or with its inputs.
+type simulatedCapability struct {
+	capabilities.CapabilityInfo
+	fixture    CapabilityFixture
+	hasFixture bool
+}
+
+var _ capabilities.ExecutableCapability = (*simulatedCapability)(nil)
+
+func newSimulatedCapability(info capabilities.CapabilityInfo, fixture CapabilityFixture, hasFixture bool) *simulatedCapability {
+	return &simulatedCapability{CapabilityInfo: info, fixture: fixture, hasFixture: hasFixture}
+}
+
+func (c *simulatedCapability) Execute(_ context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	if !c.hasFixture {
+		return capabilities.CapabilityResponse{Value: req.Inputs}, nil
+	}
+	if c.fixture.Error != "" {
+		return capabilities.CapabilityResponse{}, errors.New(c.fixture.Error)
+	}
+	outputs, err := values.NewMap(c.fixture.Outputs)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("invalid fixture outputs of %s: %w", c.ID, err)
+	}
+	return capabilities.CapabilityResponse{Value: outputs}, nil
+}
+
+func (c *simulatedCapability) RegisterToWorkflow(context.Context, capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *simulatedCapability) UnregisterFromWorkflow(context.Context, capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+// tracingStore records the steps added and updated by the engine, in order.
+type tracingStore struct {
+	store.Store
+
+	mu    sync.Mutex
+	trace []store.WorkflowExecutionStep
+}
+
+func (t *tracingStore) Add(ctx context.Context, state *store.WorkflowExecution) (store.WorkflowExecution, error) {
+	execution, err := t.Store.Add(ctx, state)
+	if err != nil {
+		return execution, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, s := range execution.Steps {
+		t.trace = append(t.trace, *s)
+	}
+	return execution, nil
+}
+
+func (t *tracingStore) UpsertStep(ctx context.Context, step *store.WorkflowExecutionStep) (store.WorkflowExecution, error) {
+	execution, err := t.Store.UpsertStep(ctx, step)
+	if err != nil {
+		return execution, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trace = append(t.trace, *execution.Steps[step.Ref])
+	return execution, nil
+}
+
+func (t *tracingStore) steps() []store.WorkflowExecutionStep {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]store.WorkflowExecutionStep{}, t.trace...)
+}
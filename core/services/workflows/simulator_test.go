@@ -0,0 +1,131 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const simulatedWorkflow = `
+triggers:
+  - id: "mercury-trigger@1.0.0"
+    config:
+      feedIds:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000"
+
+consensus:
+  - id: "offchain_reporting@1.0.0"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    config:
+      aggregation_method: "data_feeds_2_0"
+
+targets:
+  - id: "write_polygon-testnet-mumbai@1.0.0"
+    ref: "write_polygon"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+    config:
+      address: "0x3F3554832c636721F1fD1822Ccca0354576741Ef"
+`
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+
+	events, err := ParseSimulatedTriggerEvents([]byte(`[
+		{"id": "report-1", "outputs": {"price": 100}},
+		{"triggerID": "mercury-trigger@1.0.0", "outputs": {"price": 101}}
+	]`))
+	require.NoError(t, err)
+
+	simulate := func(t *testing.T, events []SimulatedTriggerEvent, fixtures map[string]CapabilityFixture) (SimulationResult, error) {
+		return Simulate(testutils.Context(t), SimulationConfig{
+			Lggr:     logger.TestLogger(t),
+			Spec:     &job.WorkflowSpec{Workflow: simulatedWorkflow, SpecType: job.YamlSpec},
+			Events:   events,
+			Fixtures: fixtures,
+		})
+	}
+
+	t.Run("stubs capabilities with fixtures", func(t *testing.T) {
+		fixtures, err := ParseCapabilityFixtures([]byte(`{
+			"offchain_reporting@1.0.0": {"outputs": {"report": "0xdeadbeef"}}
+		}`))
+		require.NoError(t, err)
+
+		result, err := simulate(t, events, fixtures)
+		require.NoError(t, err)
+
+		require.Len(t, result.Executions, 2)
+		for _, execution := range result.Executions {
+			assert.Equal(t, store.StatusCompleted, execution.Status)
+			// the target without fixture returns its inputs
+			write, err := execution.Steps["write_polygon"].Outputs.Value.Unwrap()
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"report": "0xdeadbeef"}, write)
+		}
+
+		// each execution traces its trigger, consensus and target steps in order
+		refs := map[string][]string{}
+		for _, step := range result.Trace {
+			refs[step.ExecutionID] = append(refs[step.ExecutionID], step.Ref)
+		}
+		require.Len(t, refs, 2)
+		for _, r := range refs {
+			assert.Equal(t, []string{"trigger", "evm_median", "write_polygon"}, r)
+		}
+	})
+
+	t.Run("fails steps with fixture errors", func(t *testing.T) {
+		result, err := simulate(t, events[:1], map[string]CapabilityFixture{
+			"offchain_reporting@1.0.0": {Error: "no consensus"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Executions, 1)
+		assert.Equal(t, store.StatusErrored, result.Executions[0].Status)
+		assert.ErrorContains(t, result.Executions[0].Steps["evm_median"].Outputs.Err, "no consensus")
+		assert.NotContains(t, result.Executions[0].Steps, "write_polygon")
+	})
+
+	t.Run("unknown trigger", func(t *testing.T) {
+		_, err := simulate(t, []SimulatedTriggerEvent{{TriggerID: "cron-trigger@1.0.0"}}, nil)
+		require.ErrorContains(t, err, "workflow has no trigger cron-trigger@1.0.0")
+	})
+
+	t.Run("duplicate event IDs", func(t *testing.T) {
+		_, err := simulate(t, []SimulatedTriggerEvent{{ID: "1"}, {ID: "1"}}, nil)
+		require.ErrorContains(t, err, "duplicate event ID 1")
+	})
+}
+
+func TestParseSimulationFiles(t *testing.T) {
+	t.Parallel()
+
+	events, err := ParseSimulatedTriggerEvents([]byte(`[{"id": "a", "outputs": {"price": 100, "ratio": 1.5}}]`))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, map[string]any{"price": int64(100), "ratio": 1.5}, events[0].Outputs)
+
+	_, err = ParseSimulatedTriggerEvents([]byte(`[{"outputs": {}, "payload": {}}]`))
+	require.ErrorContains(t, err, "failed to parse events")
+
+	fixtures, err := ParseCapabilityFixtures(nil)
+	require.NoError(t, err)
+	assert.Empty(t, fixtures)
+
+	fixtures, err = ParseCapabilityFixtures([]byte(`{"write@1.0.0": {"error": "reverted"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]CapabilityFixture{"write@1.0.0": {Error: "reverted"}}, fixtures)
+
+	_, err = ParseCapabilityFixtures([]byte(`{"write@1.0.0": {"value": 1}}`))
+	require.ErrorContains(t, err, "failed to parse fixtures")
+}
@@ -27,7 +27,8 @@ func copyState(es store.WorkflowExecution) store.WorkflowExecution {
 				Value: copiedov,
 			},
 
-			Inputs: mval,
+			Inputs:   mval,
+			Attempts: step.Attempts,
 		}
 
 		steps[ref] = newState
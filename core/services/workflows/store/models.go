@@ -15,6 +15,7 @@ const (
 	StatusTimeout            = "timeout"
 	StatusCompleted          = "completed"
 	StatusCompletedEarlyExit = "completed_early_exit"
+	StatusCancelled          = "cancelled"
 )
 
 var ValidStatuses = map[string]bool{
@@ -23,6 +24,16 @@ var ValidStatuses = map[string]bool{
 	StatusTimeout:            true,
 	StatusCompleted:          true,
 	StatusCompletedEarlyExit: true,
+	StatusCancelled:          true,
+}
+
+// ExecutionFilter narrows down the workflow executions returned by Store.List.
+// Zero values match all executions.
+type ExecutionFilter struct {
+	WorkflowID    string
+	Status        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
 }
 
 type StepOutput struct {
@@ -38,6 +49,10 @@ type WorkflowExecutionStep struct {
 	Inputs  *values.Map
 	Outputs StepOutput
 
+	// Attempts is the number of times the step has been executed, which
+	// exceeds one when the step is retried.
+	Attempts int
+
 	UpdatedAt *time.Time
 }
 
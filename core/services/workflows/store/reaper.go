@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const ReaperServiceName = "WorkflowExecutionReaper"
+
+// Reaper periodically deletes the workflow executions that finished more
+// than threshold ago, so that the workflow_executions and workflow_steps
+// tables do not grow without bound.
+type Reaper struct {
+	services.Service
+	eng *services.Engine
+
+	store     Store
+	clock     clockwork.Clock
+	interval  time.Duration
+	threshold time.Duration
+}
+
+// NewReaper returns a Reaper deleting executions from store every interval.
+// A zero interval disables the reaper.
+func NewReaper(store Store, interval, threshold time.Duration, clock clockwork.Clock, lggr logger.Logger) *Reaper {
+	r := &Reaper{
+		store:     store,
+		clock:     clock,
+		interval:  interval,
+		threshold: threshold,
+	}
+	r.Service, r.eng = services.Config{
+		Name:  ReaperServiceName,
+		Start: r.start,
+	}.NewServiceEngine(lggr)
+	return r
+}
+
+func (r *Reaper) start(_ context.Context) error {
+	if r.interval == 0 {
+		r.eng.Info("Workflow execution reaper is disabled")
+		return nil
+	}
+	ticker := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(r.interval)
+	r.eng.GoTick(ticker, r.Reap)
+	return nil
+}
+
+// Reap deletes the executions that finished before the threshold.
+func (r *Reaper) Reap(ctx context.Context) {
+	if r.interval > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.interval)
+		defer cancel()
+	}
+	deleted, err := r.store.DeleteFinishedBefore(ctx, r.clock.Now().Add(-r.threshold))
+	if err != nil {
+		r.eng.Errorw("Workflow execution reaper failed", "err", err)
+		return
+	}
+	r.eng.Debugw("Workflow execution reaper completed successfully", "deleted", deleted)
+}
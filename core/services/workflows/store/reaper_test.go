@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type deleteRecorder struct {
+	Store
+	befores chan time.Time
+}
+
+func (d *deleteRecorder) DeleteFinishedBefore(_ context.Context, before time.Time) (int64, error) {
+	d.befores <- before
+	return 1, nil
+}
+
+func TestReaper(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+
+	t.Run("reaps finished executions older than threshold", func(t *testing.T) {
+		store := &deleteRecorder{befores: make(chan time.Time, 100)}
+		r := NewReaper(store, time.Hour, 24*time.Hour, clock, logger.TestLogger(t))
+		r.Reap(tests.Context(t))
+		require.Len(t, store.befores, 1)
+		assert.Equal(t, clock.Now().Add(-24*time.Hour), <-store.befores)
+	})
+
+	t.Run("runs periodically", func(t *testing.T) {
+		store := &deleteRecorder{befores: make(chan time.Time, 100)}
+		r := NewReaper(store, 10*time.Millisecond, time.Hour, clock, logger.TestLogger(t))
+		servicetest.Run(t, r)
+		select {
+		case before := <-store.befores:
+			assert.Equal(t, clock.Now().Add(-time.Hour), before)
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("reaper did not run")
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		store := &deleteRecorder{befores: make(chan time.Time, 100)}
+		r := NewReaper(store, 0, time.Hour, clock, logger.TestLogger(t))
+		servicetest.Run(t, r)
+		assert.Empty(t, store.befores)
+	})
+}
@@ -2,14 +2,25 @@ package store
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
+// ErrExecutionNotFound is returned by Get for unknown executions.
+var ErrExecutionNotFound = errors.New("workflow execution not found")
+
+// ErrExecutionFinished is returned by CancelStarted for executions which are no longer started.
+var ErrExecutionFinished = errors.New("workflow execution is already finished")
+
 type Store interface {
 	Add(ctx context.Context, state *WorkflowExecution) (WorkflowExecution, error)
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	UpdateStatus(ctx context.Context, executionID string, status string) error
+	CancelStarted(ctx context.Context, executionID string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error)
+	List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error)
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 var _ Store = (*DBStore)(nil)
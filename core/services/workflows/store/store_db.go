@@ -4,18 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 	valuespb "github.com/smartcontractkit/chainlink-common/pkg/values/pb"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 )
 
 // `DBStore` is a postgres-backed
@@ -44,6 +47,7 @@ type workflowStepRow struct {
 	WorkflowExecutionID string `db:"workflow_execution_id"`
 	Ref                 string
 	Status              string
+	Attempts            int
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
@@ -59,6 +63,7 @@ type workflowExecutionWithStep struct {
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSAttempts            int        `db:"ws_attempts"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -82,6 +87,24 @@ func (d *DBStore) UpdateStatus(ctx context.Context, executionID string, status s
 	return err
 }
 
+// `CancelStarted` marks the given workflow execution as cancelled, unless it has
+// finished in the meantime, in which case it returns ErrExecutionFinished.
+func (d *DBStore) CancelStarted(ctx context.Context, executionID string) error {
+	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = $2 WHERE id = $3 AND status = $4`
+	res, err := d.db.ExecContext(ctx, sql, StatusCancelled, d.clock.Now(), executionID, StatusStarted)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("could not cancel workflow execution %s: %w", executionID, ErrExecutionFinished)
+	}
+	return nil
+}
+
 // `UpsertStep` updates the given step. This will correspond to an insert, or an update
 // depending on whether a step with the ref already exists.
 func (d *DBStore) UpsertStep(ctx context.Context, stepState *WorkflowExecutionStep) (WorkflowExecution, error) {
@@ -114,6 +137,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.attempts AS ws_attempts,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
@@ -130,7 +154,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
 	}
 	return *state, nil
 }
@@ -161,6 +185,7 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
+			Attempts:            jr.WSAttempts,
 			UpdatedAt:           jr.WSUpdatedAt,
 		})
 		if err != nil {
@@ -220,6 +245,7 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		Attempts: step.Attempts,
 	}, nil
 }
 
@@ -239,6 +265,7 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		Ref:                 state.Ref,
 		Status:              state.Status,
 		Inputs:              inpb,
+		Attempts:            state.Attempts,
 	}
 
 	if state.Outputs.Value != nil {
@@ -318,8 +345,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, attempts, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :attempts, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -328,6 +355,7 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		attempts = EXCLUDED.attempts,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -371,6 +399,7 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.attempts AS ws_attempts,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -405,6 +434,130 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 	return states, nil
 }
 
+// List returns the page of workflow executions matching filter, most recent
+// first, together with the total number of matching executions.
+func (d *DBStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	if filter.Status != "" && !ValidStatuses[filter.Status] {
+		return nil, 0, fmt.Errorf("invalid workflow execution status %q", filter.Status)
+	}
+
+	var (
+		conditions []string
+		args       []any
+	)
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.WorkflowID != "" {
+		where("workflow_id = $%d", filter.WorkflowID)
+	}
+	if filter.Status != "" {
+		where("status = $%d", filter.Status)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		where("created_at >= $%d", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		where("created_at < $%d", filter.CreatedBefore)
+	}
+	clause := ""
+	if len(conditions) > 0 {
+		clause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions`+clause, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not count workflow executions: %w", err)
+	}
+
+	sql := fmt.Sprintf(`SELECT * FROM workflow_executions%s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, clause, len(args)+1, len(args)+2)
+	var rows []workflowExecutionRow
+	err = d.db.SelectContext(ctx, &rows, sql, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not list workflow executions: %w", err)
+	}
+	if len(rows) == 0 {
+		return []WorkflowExecution{}, count, nil
+	}
+
+	ids := make([]string, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var steps []workflowStepRow
+	err = d.db.SelectContext(ctx, &steps, `SELECT * FROM workflow_steps WHERE workflow_execution_id = ANY($1) ORDER BY id`, pq.Array(ids))
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not load workflow steps: %w", err)
+	}
+	idToSteps := map[string]map[string]*WorkflowExecutionStep{}
+	for _, step := range steps {
+		state, err := stepToState(step)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, ok := idToSteps[step.WorkflowExecutionID]; !ok {
+			idToSteps[step.WorkflowExecutionID] = map[string]*WorkflowExecutionStep{}
+		}
+		idToSteps[step.WorkflowExecutionID][state.Ref] = state
+	}
+
+	executions := make([]WorkflowExecution, len(rows))
+	for i, row := range rows {
+		var wid string
+		if row.WorkflowID != nil {
+			wid = *row.WorkflowID
+		}
+		stepStates, ok := idToSteps[row.ID]
+		if !ok {
+			stepStates = map[string]*WorkflowExecutionStep{}
+		}
+		executions[i] = WorkflowExecution{
+			ExecutionID: row.ID,
+			WorkflowID:  wid,
+			Status:      row.Status,
+			Steps:       stepStates,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			FinishedAt:  row.FinishedAt,
+		}
+	}
+	return executions, count, nil
+}
+
+// DeleteFinishedBefore deletes the workflow executions that finished before
+// the given time, along with their steps, and returns how many were deleted.
+// Executions that are still running are never deleted. Executions are deleted
+// in batches, so that a large backlog does not hold locks for long.
+func (d *DBStore) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	sql := `
+	WITH batched_workflow_executions AS (
+		SELECT id FROM workflow_executions
+		WHERE status <> $1 AND finished_at < $2
+		ORDER BY finished_at ASC
+		LIMIT $3
+	)
+	DELETE FROM workflow_executions
+	USING batched_workflow_executions
+	WHERE workflow_executions.id = batched_workflow_executions.id`
+
+	var deleted int64
+	err := pg.Batch(func(_, limit uint) (uint, error) {
+		res, err := d.db.ExecContext(ctx, sql, StatusStarted, before, limit)
+		if err != nil {
+			return 0, fmt.Errorf("could not delete finished workflow executions: %w", err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+		return uint(n), nil
+	})
+	return deleted, err
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock}
 }
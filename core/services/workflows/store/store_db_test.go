@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -125,6 +126,37 @@ func Test_StoreDB_UpdateStatus(t *testing.T) {
 	assert.Equal(t, gotEs.Status, completedStatus)
 }
 
+func Test_StoreDB_CancelStarted(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	add := func(status string) string {
+		id := randomID()
+		_, err := store.Add(ctx, &WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusStarted},
+			},
+			ExecutionID: id,
+			Status:      status,
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	started := add(StatusStarted)
+	require.NoError(t, store.CancelStarted(ctx, started))
+	got, err := store.Get(ctx, started)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, got.Status)
+	assert.NotNil(t, got.FinishedAt)
+
+	completed := add(StatusCompleted)
+	require.ErrorIs(t, store.CancelStarted(ctx, completed), ErrExecutionFinished)
+	got, err = store.Get(ctx, completed)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Status)
+}
+
 func Test_StoreDB_UpdateStep(t *testing.T) {
 	store := newTestDBStore(t)
 
@@ -165,6 +197,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	assert.Equal(t, stepOne, gotStep)
 
 	stepTwo.Outputs = StepOutput{Value: nm}
+	stepTwo.Attempts = 2
 	es, err = store.UpsertStep(tests.Context(t), stepTwo)
 	require.NoError(t, err)
 
@@ -277,3 +310,85 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: pgtest.NewSqlxDB(t), lggr: logger.TestLogger(t), clock: clock}
+	ctx := tests.Context(t)
+
+	start := clock.Now()
+	var ids []string
+	for _, status := range []string{StatusCompleted, StatusErrored, StatusStarted} {
+		id := randomID()
+		ids = append(ids, id)
+		_, err := store.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: status},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, store.UpdateStatus(ctx, id, status))
+		clock.Advance(time.Hour)
+	}
+
+	executions, count, err := store.List(ctx, ExecutionFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, ids[2], executions[0].ExecutionID)
+	assert.Equal(t, ids[1], executions[1].ExecutionID)
+	assert.Equal(t, StatusErrored, executions[1].Steps["step1"].Status)
+
+	executions, count, err = store.List(ctx, ExecutionFilter{Status: StatusCompleted}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[0], executions[0].ExecutionID)
+
+	executions, count, err = store.List(ctx, ExecutionFilter{CreatedAfter: start.Add(30 * time.Minute), CreatedBefore: start.Add(90 * time.Minute)}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ExecutionID)
+
+	_, _, err = store.List(ctx, ExecutionFilter{Status: "unknown"}, 0, 10)
+	assert.Error(t, err)
+}
+
+func Test_StoreDB_DeleteFinishedBefore(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: pgtest.NewSqlxDB(t), lggr: logger.TestLogger(t), clock: clock}
+	ctx := tests.Context(t)
+
+	var ids []string
+	for _, status := range []string{StatusCompleted, StatusStarted, StatusErrored} {
+		id := randomID()
+		ids = append(ids, id)
+		_, err := store.Add(ctx, &WorkflowExecution{
+			ExecutionID: id,
+			Status:      StatusStarted,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: status},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, store.UpdateStatus(ctx, id, status))
+	}
+	clock.Advance(time.Hour)
+
+	deleted, err := store.DeleteFinishedBefore(ctx, clock.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = store.DeleteFinishedBefore(ctx, clock.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	executions, count, err := store.List(ctx, ExecutionFilter{}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[1], executions[0].ExecutionID)
+}
@@ -0,0 +1,174 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// MemStore is an in-memory data store of workflow progress, for executions
+// which need not survive a restart, like simulations.
+type MemStore struct {
+	clock clockwork.Clock
+
+	mu         sync.RWMutex
+	executions map[string]*WorkflowExecution
+}
+
+var _ Store = (*MemStore)(nil)
+
+func NewMemStore(clock clockwork.Clock) *MemStore {
+	return &MemStore{clock: clock, executions: map[string]*WorkflowExecution{}}
+}
+
+// Add adds a new execution with its steps, failing if it already exists.
+func (m *MemStore) Add(_ context.Context, state *WorkflowExecution) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.executions[state.ExecutionID]; ok {
+		return WorkflowExecution{}, fmt.Errorf("could not insert workflow execution %s: already exists", state.ExecutionID)
+	}
+
+	now := m.now()
+	execution := copyExecution(state)
+	execution.CreatedAt, execution.UpdatedAt, execution.FinishedAt = &now, &now, nil
+	for _, step := range execution.Steps {
+		step.UpdatedAt = &now
+	}
+	m.executions[state.ExecutionID] = &execution
+	return copyExecution(&execution), nil
+}
+
+// UpsertStep adds or replaces the given step of an existing execution.
+func (m *MemStore) UpsertStep(_ context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execution, ok := m.executions[step.ExecutionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", step.ExecutionID, ErrExecutionNotFound)
+	}
+
+	now := m.now()
+	s := *step
+	s.UpdatedAt = &now
+	execution.Steps[s.Ref] = &s
+	return copyExecution(execution), nil
+}
+
+// UpdateStatus updates the status of the given execution, which is finished
+// unless the status is started.
+func (m *MemStore) UpdateStatus(_ context.Context, executionID string, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return nil
+	}
+
+	now := m.now()
+	execution.Status, execution.UpdatedAt = status, &now
+	if status != StatusStarted {
+		execution.FinishedAt = &now
+	}
+	return nil
+}
+
+// CancelStarted marks the given execution as cancelled, failing with
+// ErrExecutionFinished unless it is started.
+func (m *MemStore) CancelStarted(_ context.Context, executionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	if execution.Status != StatusStarted {
+		return fmt.Errorf("could not cancel workflow execution %s: %w", executionID, ErrExecutionFinished)
+	}
+
+	now := m.now()
+	execution.Status, execution.UpdatedAt, execution.FinishedAt = StatusCancelled, &now, &now
+	return nil
+}
+
+func (m *MemStore) Get(_ context.Context, executionID string) (WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	return copyExecution(execution), nil
+}
+
+func (m *MemStore) GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error) {
+	executions, _, err := m.List(ctx, ExecutionFilter{Status: StatusStarted}, offset, limit)
+	return executions, err
+}
+
+// List returns the executions matching the filter, most recent first.
+func (m *MemStore) List(_ context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	if filter.Status != "" && !ValidStatuses[filter.Status] {
+		return nil, 0, fmt.Errorf("invalid workflow execution status %q", filter.Status)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var matches []*WorkflowExecution
+	for _, e := range m.executions {
+		switch {
+		case filter.WorkflowID != "" && e.WorkflowID != filter.WorkflowID:
+		case filter.Status != "" && e.Status != filter.Status:
+		case !filter.CreatedAfter.IsZero() && e.CreatedAt.Before(filter.CreatedAfter):
+		case !filter.CreatedBefore.IsZero() && !e.CreatedAt.Before(filter.CreatedBefore):
+		default:
+			matches = append(matches, e)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].CreatedAt.Equal(*matches[j].CreatedAt) {
+			return matches[i].CreatedAt.After(*matches[j].CreatedAt)
+		}
+		return matches[i].ExecutionID < matches[j].ExecutionID
+	})
+
+	executions := []WorkflowExecution{}
+	for i := offset; i < len(matches) && i < offset+limit; i++ {
+		executions = append(executions, copyExecution(matches[i]))
+	}
+	return executions, len(matches), nil
+}
+
+func (m *MemStore) DeleteFinishedBefore(_ context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var deleted int64
+	for id, e := range m.executions {
+		if e.Status != StatusStarted && e.FinishedAt != nil && e.FinishedAt.Before(before) {
+			delete(m.executions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// now returns the current time without monotonic clock reading, as the times read from the database.
+func (m *MemStore) now() time.Time {
+	return m.clock.Now().UTC()
+}
+
+// copyExecution copies the execution and its steps, so that they are not
+// mutated outside of the store.
+func copyExecution(e *WorkflowExecution) WorkflowExecution {
+	execution := *e
+	execution.Steps = make(map[string]*WorkflowExecutionStep, len(e.Steps))
+	for ref, step := range e.Steps {
+		s := *step
+		execution.Steps[ref] = &s
+	}
+	return execution
+}
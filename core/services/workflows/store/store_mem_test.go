@@ -0,0 +1,93 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+func TestMemStore(t *testing.T) {
+	ctx := tests.Context(t)
+	clock := clockwork.NewFakeClock()
+	s := NewMemStore(clock)
+
+	outputs, err := values.NewMap(map[string]any{"price": 100})
+	require.NoError(t, err)
+	added, err := s.Add(ctx, &WorkflowExecution{
+		ExecutionID: "<execution-ID>",
+		WorkflowID:  "<workflow-ID>",
+		Status:      StatusStarted,
+		Steps: map[string]*WorkflowExecutionStep{
+			"trigger": {ExecutionID: "<execution-ID>", Ref: "trigger", Status: StatusCompleted, Outputs: StepOutput{Value: outputs}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, clock.Now().UTC(), *added.CreatedAt)
+	assert.Nil(t, added.FinishedAt)
+
+	_, err = s.Add(ctx, &WorkflowExecution{ExecutionID: "<execution-ID>"})
+	require.ErrorContains(t, err, "already exists")
+
+	clock.Advance(time.Minute)
+	updated, err := s.UpsertStep(ctx, &WorkflowExecutionStep{
+		ExecutionID: "<execution-ID>",
+		Ref:         "write",
+		Status:      StatusErrored,
+		Outputs:     StepOutput{Err: errors.New("write failed")},
+		Attempts:    2,
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.Steps, 2)
+	assert.Equal(t, 2, updated.Steps["write"].Attempts)
+	assert.Equal(t, clock.Now().UTC(), *updated.Steps["write"].UpdatedAt)
+
+	_, err = s.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "<unknown>", Ref: "write"})
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+
+	unfinished, err := s.GetUnfinished(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 1)
+
+	require.NoError(t, s.UpdateStatus(ctx, "<execution-ID>", StatusErrored))
+	got, err := s.Get(ctx, "<execution-ID>")
+	require.NoError(t, err)
+	assert.Equal(t, StatusErrored, got.Status)
+	assert.Equal(t, clock.Now().UTC(), *got.FinishedAt)
+
+	require.ErrorIs(t, s.CancelStarted(ctx, "<execution-ID>"), ErrExecutionFinished)
+	require.ErrorIs(t, s.CancelStarted(ctx, "<unknown>"), ErrExecutionNotFound)
+	got, err = s.Get(ctx, "<execution-ID>")
+	require.NoError(t, err)
+	assert.Equal(t, StatusErrored, got.Status)
+
+	// executions returned by the store are copies
+	got.Steps["write"].Status = StatusCompleted
+	got, err = s.Get(ctx, "<execution-ID>")
+	require.NoError(t, err)
+	assert.Equal(t, StatusErrored, got.Steps["write"].Status)
+
+	executions, count, err := s.List(ctx, ExecutionFilter{Status: StatusErrored}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, executions, 1)
+	executions, count, err = s.List(ctx, ExecutionFilter{WorkflowID: "<other-workflow-ID>"}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Empty(t, executions)
+
+	deleted, err := s.DeleteFinishedBefore(ctx, clock.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+	deleted, err = s.DeleteFinishedBefore(ctx, clock.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = s.Get(ctx, "<execution-ID>")
+	require.ErrorIs(t, err, ErrExecutionNotFound)
+}
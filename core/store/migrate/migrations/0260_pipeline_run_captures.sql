@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN capture_responses boolean NOT NULL DEFAULT false;
+CREATE TABLE pipeline_run_captures (
+    pipeline_run_id bigint PRIMARY KEY REFERENCES pipeline_runs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    capture jsonb NOT NULL,
+    created_at timestamptz NOT NULL
+);
+-- +goose Down
+DROP TABLE pipeline_run_captures;
+ALTER TABLE jobs DROP COLUMN capture_responses;
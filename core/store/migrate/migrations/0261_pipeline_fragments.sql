@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE pipeline_fragments (
+    id serial PRIMARY KEY,
+    name text NOT NULL,
+    version integer NOT NULL CHECK (version > 0),
+    dot_dag_source text NOT NULL,
+    created_at timestamptz NOT NULL,
+    UNIQUE (name, version)
+);
+-- +goose Down
+DROP TABLE pipeline_fragments;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN auth_scheme text NOT NULL DEFAULT 'none' CHECK (auth_scheme IN ('none', 'hmac', 'mtls')),
+    ADD COLUMN encrypted_auth jsonb;
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN auth_scheme,
+    DROP COLUMN encrypted_auth;
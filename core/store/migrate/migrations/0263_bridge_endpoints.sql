@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN fallback_urls text[] NOT NULL DEFAULT '{}',
+    ADD COLUMN selection_policy text NOT NULL DEFAULT 'primary' CHECK (selection_policy IN ('primary', 'roundrobin', 'fastest'));
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN fallback_urls,
+    DROP COLUMN selection_policy;
@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE workflow_steps ADD COLUMN attempts integer NOT NULL DEFAULT 0;
+-- +goose Down
+ALTER TABLE workflow_steps DROP COLUMN attempts;
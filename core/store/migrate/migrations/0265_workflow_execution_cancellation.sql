@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TYPE workflow_status ADD VALUE 'cancelled';
+ALTER TABLE workflow_specs ADD COLUMN max_execution_duration bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE workflow_specs DROP COLUMN max_execution_duration;
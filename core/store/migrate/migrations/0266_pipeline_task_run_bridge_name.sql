@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN bridge_name text;
+
+-- +goose Down
+ALTER TABLE pipeline_task_runs DROP COLUMN bridge_name;
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
 	"github.com/gin-gonic/gin"
@@ -46,10 +47,19 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 	if len(strings.TrimSpace(u)) == 0 {
 		fe.Add("URL must be present")
 	}
+	for _, fallback := range bt.FallbackURLs {
+		if len(strings.TrimSpace(fallback.String())) == 0 {
+			fe.Add("FallbackURLs must not be empty")
+			break
+		}
+	}
 	if bt.MinimumContractPayment != nil &&
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.Auth != nil && (bt.AuthScheme == "" || bt.AuthScheme == bridges.AuthSchemeNone) {
+		fe.Add("Auth requires an AuthScheme")
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -81,6 +91,10 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, e)
 		return
 	}
+	if e := btc.setAuth(bt, btr, bta); e != nil {
+		jsonAPIError(c, http.StatusBadRequest, e)
+		return
+	}
 	if e := orm.CreateBridgeType(ctx, bt); e != nil {
 		jsonAPIError(c, http.StatusInternalServerError, e)
 		return
@@ -96,19 +110,27 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusConflict, apiErr)
 		return
 	}
-	resource := presenters.NewBridgeResource(*bt)
+	resource := btc.newResource(*bt)
 	resource.IncomingToken = bta.IncomingToken
+	resource.HMACSecret = bta.HMACSecret
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeCreated, map[string]interface{}{
 		"bridgeName":                   bta.Name,
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeAuthScheme":             bt.AuthScheme,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
 }
 
+// setAuth encrypts the credentials of the request with the keystore password.
+func (btc *BridgeTypesController) setAuth(bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest, bta *bridges.BridgeTypeAuthentication) error {
+	cfg := btc.App.GetConfig()
+	return bt.SetAuth(btr, bta, cfg.Password().Keystore(), utils.GetScryptParams(cfg))
+}
+
 // Index lists Bridges, one page at a time.
 func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int) {
 	ctx := c.Request.Context()
@@ -116,7 +138,7 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resources = append(resources, *btc.newResource(bridge))
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +165,14 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, btc.newResource(bt), "bridge")
+}
+
+// newResource returns the resource of bt along with the health of its endpoints.
+func (btc *BridgeTypesController) newResource(bt bridges.BridgeType) *presenters.BridgeResource {
+	resource := presenters.NewBridgeResource(bt)
+	resource.Endpoints = presenters.NewBridgeEndpointResources(btc.App.BridgeHealthChecker().Health(bt))
+	return resource
 }
 
 // Update can change the restricted attributes for a bridge
@@ -173,10 +202,20 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	// credentials may be rotated without repeating the scheme
+	if btr.AuthScheme == "" && btr.Auth != nil {
+		btr.AuthScheme = bt.AuthScheme
+	}
 	if err := ValidateBridgeType(btr); err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	// a generated hmac secret is only ever shown in this response
+	bta := &bridges.BridgeTypeAuthentication{}
+	if err := btc.setAuth(&bt, btr, bta); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
 	if err := orm.UpdateBridgeType(ctx, &bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -187,9 +226,12 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeAuthScheme":             bt.AuthScheme,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := btc.newResource(bt)
+	resource.HMACSecret = bta.HMACSecret
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -268,6 +268,49 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
 }
 
+func TestBridgeTypesController_Update_HMAC(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	bridgeName := testutils.RandomizeName("hmacbridge")
+	bt := &bridges.BridgeType{
+		Name: bridges.MustParseBridgeName(bridgeName),
+		URL:  cltest.WebURL(t, "http://mybridge"),
+	}
+	ctx := testutils.Context(t)
+	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+
+	update := func(body string) presenters.BridgeResource {
+		resp, cleanup := client.Patch("/v2/bridge_types/"+bridgeName, bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var resource presenters.BridgeResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resource))
+		return resource
+	}
+
+	// the secret generated when switching to hmac is returned
+	resource := update(fmt.Sprintf(`{"name": "%s","url":"http://mybridge","authScheme":"hmac"}`, bridgeName))
+	require.NotEmpty(t, resource.HMACSecret)
+	found, err := app.BridgeORM().FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	auth, err := found.EncryptedAuth.Decrypt(app.GetConfig().Password().Keystore())
+	require.NoError(t, err)
+	assert.Equal(t, resource.HMACSecret, auth.HMACSecret)
+
+	// repeating the scheme keeps the secret
+	resource = update(fmt.Sprintf(`{"name": "%s","url":"http://mybridge","authScheme":"hmac"}`, bridgeName))
+	assert.Empty(t, resource.HMACSecret)
+	found, err = app.BridgeORM().FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	kept, err := found.EncryptedAuth.Decrypt(app.GetConfig().Password().Keystore())
+	require.NoError(t, err)
+	assert.Equal(t, auth.HMACSecret, kept.HMACSecret)
+}
+
 func TestBridgeController_Show(t *testing.T) {
 	t.Parallel()
 
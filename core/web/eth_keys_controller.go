@@ -338,6 +338,45 @@ func (ekc *ETHKeysController) Chain(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+// Resync detects nonce gaps for an ETH key and, unless dryRun is set, repairs them
+// Example:
+// "POST <application>/keys/evm/resync?address=<address>&evmChainID=<chainID>&dryRun=true"
+func (ekc *ETHKeysController) Resync(c *gin.Context) {
+	keyID := c.Query("address")
+	if !common.IsHexAddress(keyID) {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid address: %s, must be hex address", keyID))
+		return
+	}
+	address := common.HexToAddress(keyID)
+
+	chain, ok := ekc.getChain(c, c.Query("evmChainID"))
+	if !ok {
+		return
+	}
+
+	dryRun := false
+	if dryRunStr := c.Query("dryRun"); dryRunStr != "" {
+		var err error
+		dryRun, err = strconv.ParseBool(dryRunStr)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, errors.Wrapf(err, "invalid value for dryRun: expected boolean, got: %s", dryRunStr))
+			return
+		}
+	}
+
+	report, err := chain.TxManager().RepairSequenceGaps(c.Request.Context(), address, dryRun)
+	if err != nil {
+		if strings.Contains(err.Error(), "key state not found with address") {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewETHKeyResyncResource(*ubig.New(chain.ID()), report), "ethKeyResync")
+}
+
 func (ekc *ETHKeysController) setEthBalance(bal *big.Int) presenters.NewETHKeyOption {
 	return presenters.SetETHKeyEthBalance((*assets.Eth)(bal))
 }
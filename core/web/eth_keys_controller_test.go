@@ -647,6 +647,89 @@ func TestETHKeysController_ChainFailure_MissingChainID(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 }
 
+func TestETHKeysController_ResyncSuccess_DryRun(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+
+	require.NoError(t, app.KeyStore.Unlock(ctx, cltest.Password))
+
+	_, addr := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, addr)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, addr)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, addr)
+	ethClient.On("PendingNonceAt", mock.Anything, addr).Return(uint64(1), nil)
+
+	client := app.NewHTTPClient(nil)
+	resyncURL := url.URL{Path: "/v2/keys/evm/resync"}
+	query := resyncURL.Query()
+
+	query.Set("address", addr.Hex())
+	query.Set("evmChainID", cltest.FixtureChainID.String())
+	query.Set("dryRun", "true")
+
+	resyncURL.RawQuery = query.Encode()
+	resp, cleanup := client.Post(resyncURL.String(), nil)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var report webpresenters.ETHKeyResyncResource
+	err := cltest.ParseJSONAPIResponse(t, resp, &report)
+	require.NoError(t, err)
+
+	assert.Equal(t, addr.Hex(), report.Address)
+	assert.Equal(t, int64(1), report.NextNonceOnChain)
+	require.NotNil(t, report.HighestStoredNonce)
+	assert.Equal(t, int64(3), *report.HighestStoredNonce)
+	assert.Equal(t, []int64{2}, report.MissingNonces)
+	assert.Empty(t, report.FilledNonces)
+	assert.Empty(t, report.RequeuedTxIDs)
+	assert.Empty(t, report.SkippedTxs)
+	assert.True(t, report.DryRun)
+}
+
+func TestETHKeysController_ResyncFailure_InvalidDryRun(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+
+	require.NoError(t, app.KeyStore.Unlock(ctx, cltest.Password))
+
+	_, addr := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(nil)
+	resyncURL := url.URL{Path: "/v2/keys/evm/resync"}
+	query := resyncURL.Query()
+
+	query.Set("address", addr.Hex())
+	query.Set("evmChainID", cltest.FixtureChainID.String())
+	query.Set("dryRun", "invalid")
+
+	resyncURL.RawQuery = query.Encode()
+	resp, cleanup := client.Post(resyncURL.String(), nil)
+	defer cleanup()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
 func TestETHKeysController_DeleteSuccess(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -1,6 +1,8 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -10,5 +12,21 @@ func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), relay.NetworkEVM)
 
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newEVMNodeResourceWithScore(app), app.GetAuditLogger())
+}
+
+// newEVMNodeResourceWithScore returns a constructor of EVMNodeResource which
+// includes the score of the node, if its chain reports scores.
+func newEVMNodeResourceWithScore(app chainlink.Application) func(types.NodeStatus) presenters.EVMNodeResource {
+	return func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		chain, err := app.GetRelayers().LegacyEVMChains().Get(status.ChainID)
+		if err != nil {
+			return r
+		}
+		if score, ok := chain.Client().NodeScores()[status.Name]; ok {
+			r.Score = &score
+		}
+		return r
+	}
 }
@@ -3,15 +3,40 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmtxmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper1_3"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/keeper_registry_wrapper2_0"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/offchain_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2_5"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 )
 
+// jobTypeContractABIs lists the ABIs of the contracts jobs of the given type transmit to. They are used to decode
+// custom errors returned by simulated transactions.
+var jobTypeContractABIs = map[job.Type][]string{
+	job.DirectRequest:     {operator_wrapper.OperatorABI},
+	job.FluxMonitor:       {flux_aggregator_wrapper.FluxAggregatorABI},
+	job.OffchainReporting: {offchain_aggregator_wrapper.OffchainAggregatorABI},
+	job.Keeper:            {keeper_registry_wrapper1_3.KeeperRegistryABI, keeper_registry_wrapper2_0.KeeperRegistryABI},
+	job.VRF:               {vrf_coordinator_v2.VRFCoordinatorV2ABI, vrf_coordinator_v2_5.VRFCoordinatorV25ABI},
+}
+
 // TransactionsController displays Ethereum transactions requests.
 type TransactionsController struct {
 	App chainlink.Application
@@ -47,3 +72,107 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Simulate executes a transaction that was not confirmed yet against the latest state of the chain, without
+// broadcasting it. Custom errors are decoded using the contract ABIs of the job that created the transaction.
+// Example:
+//
+//	"<application>/transactions/evm/:ID/simulate"
+func (tc *TransactionsController) Simulate(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	tx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch tx.State {
+	case txmgr.TxUnstarted, txmgr.TxInProgress, txmgr.TxUnconfirmed:
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("cannot simulate transaction in state %s", tx.State))
+		return
+	}
+
+	chain, err := tc.App.GetRelayers().LegacyEVMChains().Get(tx.ChainID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	errorsABI, err := tc.jobErrorsABI(c, tx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	msg := ethereum.CallMsg{
+		From:  tx.FromAddress,
+		To:    &tx.ToAddress,
+		Gas:   tx.FeeLimit,
+		Value: &tx.Value,
+		Data:  tx.EncodedPayload,
+	}
+	result, err := evmclient.SimulateCall(c, chain.Client(), msg, errorsABI)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadGateway, errors.Wrap(err, "failed to simulate transaction"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEthTxSimulationResource(tx, result), "transaction_simulation")
+}
+
+// jobErrorsABI returns an ABI with the custom errors of the contracts that the job, which created the transaction,
+// transmits to. nil is returned, if the transaction was not created by a job or the job type is not known.
+func (tc *TransactionsController) jobErrorsABI(c *gin.Context, tx evmtxmgr.Tx) (*abi.ABI, error) {
+	meta, err := tx.GetMeta()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse transaction meta")
+	}
+	if meta == nil || meta.JobID == nil {
+		return nil, nil
+	}
+
+	jb, err := tc.App.JobORM().FindJob(c, *meta.JobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	abis, ok := jobTypeContractABIs[jb.Type]
+	if !ok {
+		return nil, nil
+	}
+	errorsABI, err := mergeErrorsABIs(abis)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse ABI for job type %s", jb.Type)
+	}
+	return errorsABI, nil
+}
+
+// mergeErrorsABIs returns an ABI with the custom errors of all given ABIs. The errors are keyed by their selector
+// rather than their name, since contracts may define errors with the same name but different arguments, e.g.
+// InvalidConsumer(uint64,address) in VRF v2 and InvalidConsumer(uint256,address) in VRF v2.5.
+func mergeErrorsABIs(abis []string) (*abi.ABI, error) {
+	errorsABI := &abi.ABI{Errors: map[string]abi.Error{}}
+	for _, abiJSON := range abis {
+		parsed, err := abi.JSON(strings.NewReader(abiJSON))
+		if err != nil {
+			return nil, err
+		}
+		for _, abiErr := range parsed.Errors {
+			errorsABI.Errors[abiErr.ID.Hex()] = abiErr
+		}
+	}
+	return errorsABI, nil
+}
@@ -0,0 +1,39 @@
+package web
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2_5"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestMergeErrorsABIs(t *testing.T) {
+	t.Parallel()
+
+	errorsABI, err := mergeErrorsABIs(jobTypeContractABIs[job.VRF])
+	require.NoError(t, err)
+
+	consumer := common.HexToAddress("0x8829a8cb6c4E8a1d50a4F9D9F4E7e8e3E6dB0f67")
+
+	v2, err := vrf_coordinator_v2.VRFCoordinatorV2MetaData.GetAbi()
+	require.NoError(t, err)
+	v2Err := v2.Errors["InvalidConsumer"]
+	data, err := v2Err.Inputs.Pack(uint64(7), consumer)
+	require.NoError(t, err)
+	assert.Equal(t, "InvalidConsumer(7, "+consumer.Hex()+")", evmclient.DecodeRevertData(append(v2Err.ID[:4:4], data...), errorsABI))
+
+	v25, err := vrf_coordinator_v2_5.VRFCoordinatorV25MetaData.GetAbi()
+	require.NoError(t, err)
+	v25Err := v25.Errors["InvalidConsumer"]
+	require.NotEqual(t, v2Err.ID, v25Err.ID)
+	data, err = v25Err.Inputs.Pack(big.NewInt(8), consumer)
+	require.NoError(t, err)
+	assert.Equal(t, "InvalidConsumer(8, "+consumer.Hex()+")", evmclient.DecodeRevertData(append(v25Err.ID[:4:4], data...), errorsABI))
+}
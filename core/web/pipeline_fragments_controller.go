@@ -0,0 +1,150 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PipelineFragmentRequest is the request to create a new version of a pipeline fragment.
+type PipelineFragmentRequest struct {
+	Name         string `json:"name"`
+	DotDagSource string `json:"dotDagSource"`
+}
+
+// PipelineFragmentsController manages pipeline fragments, which jobs invoke with the `fragment` task.
+type PipelineFragmentsController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest version of every fragment, one page at a time.
+// Example:
+// "GET <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Index(c *gin.Context, size, page, offset int) {
+	fragments, count, err := pfc.App.PipelineORM().FindFragments(c.Request.Context(), offset, size)
+
+	var resources []presenters.PipelineFragmentResource
+	for _, f := range fragments {
+		resources = append(resources, *presenters.NewPipelineFragmentResource(f))
+	}
+
+	paginatedResponse(c, "pipelineFragments", size, page, resources, count, err)
+}
+
+// Create stores a new version of a fragment, and returns it with the jobs which invoke the fragment.
+// Example:
+// "POST <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := PipelineFragmentRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := pipeline.ValidateFragment(request.Name, request.DotDagSource); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	fragment, err := pfc.App.PipelineORM().CreateFragment(ctx, request.Name, request.DotDagSource)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobIDs, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, fragment.Name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %+v", err))
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]interface{}{
+		"fragmentName":    fragment.Name,
+		"fragmentVersion": fragment.Version,
+		"jobIDs":          jobIDs,
+	})
+
+	resource := presenters.NewPipelineFragmentResource(fragment)
+	resource.JobIDs = jobIDs
+	jsonAPIResponse(c, resource, "pipelineFragments")
+}
+
+// Show returns the latest version of a fragment, or the version given by the
+// `version` query parameter, with the jobs which invoke the fragment.
+// Example:
+// "GET <application>/pipeline/fragments/:Name"
+func (pfc *PipelineFragmentsController) Show(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("Name")
+
+	var version int64
+	if v := c.Query("version"); v != "" {
+		var err error
+		if version, err = strconv.ParseInt(v, 10, 32); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid version"))
+			return
+		}
+	}
+
+	fragment, err := pfc.App.PipelineORM().FindFragment(ctx, name, int32(version))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobIDs, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, fragment.Name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %+v", err))
+		return
+	}
+
+	resource := presenters.NewPipelineFragmentResource(fragment)
+	resource.JobIDs = jobIDs
+	jsonAPIResponse(c, resource, "pipelineFragments")
+}
+
+// Destroy deletes all versions of a fragment, unless it is invoked by any job.
+// Example:
+// "DELETE <application>/pipeline/fragments/:Name"
+func (pfc *PipelineFragmentsController) Destroy(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("Name")
+
+	fragment, err := pfc.App.PipelineORM().FindFragment(ctx, name, 0)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for fragment: %+v", err))
+		return
+	}
+	jobIDs, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %+v", err))
+		return
+	}
+	if len(jobIDs) > 0 {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove the fragment because jobs %v are associated with it", jobIDs))
+		return
+	}
+	if err = pfc.App.PipelineORM().DeleteFragment(ctx, name); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete fragment: %+v", err))
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]interface{}{"fragmentName": name})
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragments")
+}
@@ -1,16 +1,21 @@
 package web
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -85,6 +90,32 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, res, "pipelineRun")
 }
 
+// Replay re-executes a stored pipeline run from the http, bridge, chain and
+// transaction task results captured when it ran, and returns both runs so they can be compared.
+// Only runs of jobs with captureResponses enabled can be replayed.
+// Example:
+// "POST <application>/pipeline/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	original, replay, err := pipeline.Replay(c.Request.Context(), prc.App.GetConfig().JobPipeline(), prc.App.GetLogger(), prc.App.PipelineORM(), pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("run %d not found or has no captured responses", pipelineRun.ID))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineReplayResource(original, *replay, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineReplay")
+}
+
 // Create triggers a pipeline run for a job.
 // Example:
 // "POST <application>/jobs/:ID/runs"
@@ -165,9 +196,34 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	bt, err := prc.findResumedBridge(c.Request.Context(), taskID)
+	if errors.Is(err, errUnknownResumedBridge) {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if bt != nil && bt.AuthScheme == bridges.AuthSchemeHMAC {
+		auth, err := prc.App.BridgeAuthenticator().Credentials(*bt)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if err := bridges.VerifySignature(auth.HMACSecret, c.Request.Header, body, time.Now(), bridges.DefaultSignatureMaxSkew); err != nil {
+			jsonAPIError(c, http.StatusUnauthorized, errors.Wrapf(err, "bridge %q requires signed callbacks", bt.Name))
+			return
+		}
+	}
+
 	rr := pipeline.ResumeRequest{}
-	decoder := json.NewDecoder(c.Request.Body)
-	err = errors.Wrap(decoder.Decode(&rr), "failed to unmarshal JSON body")
+	err = errors.Wrap(json.Unmarshal(body, &rr), "failed to unmarshal JSON body")
 	if err != nil {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
@@ -186,3 +242,52 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 	prc.App.GetAuditLogger().Audit(audit.UnauthedRunResumed, map[string]interface{}{"runID": c.Param("runID")})
 	c.Status(http.StatusOK)
 }
+
+var errUnknownResumedBridge = errors.New("cannot determine the bridge of the resumed task run")
+
+// findResumedBridge returns the bridge of the bridge task run with taskID,
+// or nil if there is no such task run. errUnknownResumedBridge is returned if
+// the bridge cannot be determined, so that callbacks of bridges requiring
+// signatures are never accepted unsigned.
+func (prc *PipelineRunsController) findResumedBridge(ctx context.Context, taskID uuid.UUID) (*bridges.BridgeType, error) {
+	orm := prc.App.PipelineORM()
+	tr, err := orm.FindTaskRun(ctx, taskID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && tr.Type != pipeline.TaskTypeBridge) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	name := tr.BridgeName.String
+	if !tr.BridgeName.Valid {
+		// Task runs suspended before the bridge name was stored only have
+		// the name in the spec, which is unusable if it is resolved at run time
+		name, err = prc.specBridgeName(ctx, tr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	bt, err := prc.App.BridgeORM().FindBridge(ctx, bridges.BridgeName(name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrapf(errUnknownResumedBridge, "bridge %q not found", name)
+	}
+	return &bt, err
+}
+
+// specBridgeName returns the static bridge name of the bridge task of tr in
+// the pipeline spec.
+func (prc *PipelineRunsController) specBridgeName(ctx context.Context, tr pipeline.TaskRun) (string, error) {
+	run, err := prc.App.PipelineORM().FindRun(ctx, tr.PipelineRunID)
+	if err != nil {
+		return "", err
+	}
+	p, err := run.PipelineSpec.ParsePipeline()
+	if err != nil {
+		return "", err
+	}
+	task, ok := p.ByDotID(tr.DotID).(*pipeline.BridgeTask)
+	if !ok || task.Name == "" || strings.Contains(task.Name, "$(") {
+		return "", errUnknownResumedBridge
+	}
+	return task.Name, nil
+}
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,15 +18,20 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -250,6 +256,84 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Resume_DynamicBridgeName(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	btr := &bridges.BridgeTypeRequest{
+		Name:       bridges.MustParseBridgeName(fmt.Sprintf("signed_%s", uuid.New())),
+		URL:        cltest.WebURL(t, "https://bridge.example.com/api"),
+		AuthScheme: bridges.AuthSchemeHMAC,
+		Auth:       &bridges.BridgeAuth{HMACSecret: "secret"},
+	}
+	_, bt, err := bridges.NewBridgeType(btr)
+	require.NoError(t, err)
+	require.NoError(t, bt.SetAuth(btr, nil, app.GetConfig().Password().Keystore(), utils.FastScryptParams))
+	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+
+	jb, err := webhook.ValidatedWebhookSpec(ctx, fmt.Sprintf(testspecs.WebhookSpecWithBodyTemplate, uuid.New(), bt.Name.String()), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(ctx, &jb))
+
+	// The bridge name is only known at run time, so it cannot be found in the spec
+	_, err = app.GetDB().ExecContext(ctx, `UPDATE pipeline_specs SET dot_dag_source = $1 WHERE id = $2`, `
+decode [type=jsonparse path="request" data="$(jobRun.requestBody)"]
+ds     [type=bridge async=true name="$(decode.bridge)"]
+
+decode -> ds
+`, jb.PipelineSpecID)
+	require.NoError(t, err)
+
+	// createSuspendedRun inserts a run whose bridge task is waiting for its callback
+	createSuspendedRun := func(bridgeName null.String) uuid.UUID {
+		now := time.Now()
+		taskID := uuid.New()
+		run := &pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			PruningKey:     jb.ID,
+			State:          pipeline.RunStatusSuspended,
+			CreatedAt:      now,
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{ID: uuid.New(), Type: pipeline.TaskTypeJSONParse, DotID: "decode", CreatedAt: now, FinishedAt: null.TimeFrom(now),
+					Output: jsonserializable.JSONSerializable{Val: map[string]interface{}{"bridge": bt.Name.String()}, Valid: true}},
+				{ID: taskID, Type: pipeline.TaskTypeBridge, DotID: "ds", CreatedAt: now, BridgeName: bridgeName},
+			},
+		}
+		require.NoError(t, app.PipelineORM().CreateRun(ctx, run))
+		return taskID
+	}
+
+	resume := func(taskID uuid.UUID, sign bool) int {
+		body := []byte(`{"value": "123.45"}`)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPatch, app.Server.URL+"/v2/resume/"+taskID.String(), bytes.NewReader(body))
+		require.NoError(t, err)
+		if sign {
+			timestamp, signature := bridges.SignatureHeaders("secret", body, time.Now())
+			req.Header.Set(bridges.HeaderTimestamp, timestamp)
+			req.Header.Set(bridges.HeaderSignature, signature)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("verifies the signature against the bridge the request was sent to", func(t *testing.T) {
+		taskID := createSuspendedRun(null.StringFrom(bt.Name.String()))
+		assert.Equal(t, http.StatusUnauthorized, resume(taskID, false))
+		assert.Equal(t, http.StatusOK, resume(taskID, true))
+	})
+
+	t.Run("rejects callbacks if the bridge is unknown", func(t *testing.T) {
+		taskID := createSuspendedRun(null.String{})
+		assert.Equal(t, http.StatusUnauthorized, resume(taskID, false))
+		assert.Equal(t, http.StatusUnauthorized, resume(taskID, true))
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -17,7 +17,22 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	FallbackURLs           []string     `json:"fallbackURLs"`
+	SelectionPolicy        string       `json:"selectionPolicy"`
+	AuthScheme             string       `json:"authScheme"`
+	// The HMACSecret is only provided when it is generated for a Bridge
+	HMACSecret string                   `json:"hmacSecret,omitempty"`
+	Endpoints  []BridgeEndpointResource `json:"endpoints,omitempty"`
+	CreatedAt  time.Time                `json:"createdAt"`
+}
+
+// BridgeEndpointResource represents the health of a URL of a Bridge.
+type BridgeEndpointResource struct {
+	URL       string     `json:"url"`
+	Status    string     `json:"status"`
+	LatencyMS int64      `json:"latencyMs"`
+	CheckedAt *time.Time `json:"checkedAt"`
+	Error     string     `json:"error,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,6 +42,10 @@ func (r BridgeResource) GetName() string {
 
 // NewBridgeResource constructs a new BridgeResource
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
+	fallbackURLs := []string{}
+	for _, u := range b.FallbackURLs {
+		fallbackURLs = append(fallbackURLs, u.String())
+	}
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
 		JAID:                   NewJAID(b.Name.String()),
@@ -35,6 +54,28 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		FallbackURLs:           fallbackURLs,
+		SelectionPolicy:        string(b.SelectionPolicy),
+		AuthScheme:             string(b.AuthScheme),
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+// NewBridgeEndpointResources constructs the endpoints of a BridgeResource
+func NewBridgeEndpointResources(health []bridges.EndpointHealth) []BridgeEndpointResource {
+	var resources []BridgeEndpointResource
+	for _, h := range health {
+		r := BridgeEndpointResource{
+			URL:       h.URL.String(),
+			Status:    string(h.Status),
+			LatencyMS: h.Latency.Milliseconds(),
+			Error:     h.Error,
+		}
+		if !h.CheckedAt.IsZero() {
+			checkedAt := h.CheckedAt
+			r.CheckedAt = &checkedAt
+		}
+		resources = append(resources, r)
+	}
+	return resources
+}
@@ -18,6 +18,8 @@ func TestBridgeResource(t *testing.T) {
 	t.Parallel()
 
 	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	fallbackURL, err := url.Parse("https://fallback.example.com/api")
+	require.NoError(t, err)
 	url, err := url.Parse("https://bridge.example.com/api")
 	require.NoError(t, err)
 
@@ -27,6 +29,9 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
+		FallbackURLs:           bridges.WebURLs{models.WebURL(*fallbackURL)},
+		SelectionPolicy:        bridges.SelectionPolicyFastest,
+		AuthScheme:             bridges.AuthSchemeHMAC,
 		CreatedAt:              timestamp,
 	}
 
@@ -46,6 +51,9 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"fallbackURLs":["https://fallback.example.com/api"],
+			"selectionPolicy":"fastest",
+			"authScheme":"hmac",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -54,8 +62,12 @@ func TestBridgeResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
-	// Test insertion of IncomingToken
+	// Test insertion of IncomingToken and endpoints
 	r.IncomingToken = "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI"
+	r.Endpoints = NewBridgeEndpointResources([]bridges.EndpointHealth{
+		{URL: bridge.URL, Status: bridges.EndpointStatusHealthy, Latency: 15 * time.Millisecond, CheckedAt: timestamp},
+		{URL: models.WebURL(*fallbackURL), Status: bridges.EndpointStatusUnknown},
+	})
 	b, err = jsonapi.Marshal(r)
 	require.NoError(t, err)
 
@@ -71,6 +83,13 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"fallbackURLs":["https://fallback.example.com/api"],
+			"selectionPolicy":"fastest",
+			"authScheme":"hmac",
+			"endpoints":[
+				{"url":"https://bridge.example.com/api","status":"healthy","latencyMs":15,"checkedAt":"2000-01-01T00:00:00Z"},
+				{"url":"https://fallback.example.com/api","status":"unknown","latencyMs":0,"checkedAt":null}
+			],
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
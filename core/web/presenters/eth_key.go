@@ -1,10 +1,12 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 )
@@ -74,3 +76,66 @@ func SetETHKeyMaxGasPriceWei(maxGasPriceWei *big.Big) NewETHKeyOption {
 		r.MaxGasPriceWei = maxGasPriceWei
 	}
 }
+
+// ETHKeyResyncResource represents the nonce gaps found for an ETH key and how
+// they were repaired
+type ETHKeyResyncResource struct {
+	JAID
+	EVMChainID         big.Big                 `json:"evmChainID"`
+	Address            string                  `json:"address"`
+	NextNonceOnChain   int64                   `json:"nextNonceOnChain"`
+	HighestStoredNonce *int64                  `json:"highestStoredNonce"`
+	MissingNonces      []int64                 `json:"missingNonces"`
+	FilledNonces       []int64                 `json:"filledNonces"`
+	ResequencedTxIDs   []string                `json:"resequencedTxIDs"`
+	RequeuedTxIDs      []string                `json:"requeuedTxIDs"`
+	SkippedTxs         []ETHKeyResyncSkippedTx `json:"skippedTxs"`
+	DryRun             bool                    `json:"dryRun"`
+}
+
+// ETHKeyResyncSkippedTx is a transaction that was not resequenced, and why
+type ETHKeyResyncSkippedTx struct {
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ETHKeyResyncResource) GetName() string {
+	return "ethKeyResyncs"
+}
+
+// NewETHKeyResyncResource constructs a new ETHKeyResyncResource from a report
+func NewETHKeyResyncResource(chainID big.Big, report txmgr.SequenceGapReport) *ETHKeyResyncResource {
+	r := &ETHKeyResyncResource{
+		JAID:             NewPrefixedJAID(report.Address.Hex(), chainID.String()),
+		EVMChainID:       chainID,
+		Address:          report.Address.Hex(),
+		NextNonceOnChain: report.NextSequenceOnChain.Int64(),
+		MissingNonces:    []int64{},
+		FilledNonces:     []int64{},
+		ResequencedTxIDs: []string{},
+		RequeuedTxIDs:    []string{},
+		SkippedTxs:       []ETHKeyResyncSkippedTx{},
+		DryRun:           report.DryRun,
+	}
+	if report.HighestStoredSequence != nil {
+		highest := report.HighestStoredSequence.Int64()
+		r.HighestStoredNonce = &highest
+	}
+	for _, n := range report.MissingSequences {
+		r.MissingNonces = append(r.MissingNonces, n.Int64())
+	}
+	for _, n := range report.FilledSequences {
+		r.FilledNonces = append(r.FilledNonces, n.Int64())
+	}
+	for _, id := range report.ResequencedTxIDs {
+		r.ResequencedTxIDs = append(r.ResequencedTxIDs, strconv.FormatInt(id, 10))
+	}
+	for _, id := range report.RequeuedTxIDs {
+		r.RequeuedTxIDs = append(r.RequeuedTxIDs, strconv.FormatInt(id, 10))
+	}
+	for _, tx := range report.SkippedTxs {
+		r.SkippedTxs = append(r.SkippedTxs, ETHKeyResyncSkippedTx{ID: strconv.FormatInt(tx.ID, 10), Reason: tx.Reason})
+	}
+	return r
+}
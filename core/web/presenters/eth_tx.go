@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 )
@@ -77,3 +78,51 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// EthTxSimulationResource represents the result of simulating an Ethereum Transaction against the latest state of the chain.
+type EthTxSimulationResource struct {
+	JAID
+	State        string          `json:"state"`
+	Data         hexutil.Bytes   `json:"data"`
+	From         *common.Address `json:"from"`
+	To           *common.Address `json:"to"`
+	Value        string          `json:"value"`
+	GasLimit     string          `json:"gasLimit"`
+	EVMChainID   big.Big         `json:"evmChainID"`
+	Reverted     bool            `json:"reverted"`
+	GasUsed      string          `json:"gasUsed"`
+	ReturnData   hexutil.Bytes   `json:"returnData"`
+	Error        string          `json:"error"`
+	RevertData   hexutil.Bytes   `json:"revertData"`
+	RevertReason string          `json:"revertReason"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EthTxSimulationResource) GetName() string {
+	return "evm_transaction_simulations"
+}
+
+// NewEthTxSimulationResource generates a EthTxSimulationResource from an Eth.Tx and the result of its simulation.
+func NewEthTxSimulationResource(tx txmgr.Tx, result evmclient.CallSimulationResult) EthTxSimulationResource {
+	v := assets.Eth(tx.Value)
+	r := EthTxSimulationResource{
+		JAID:         NewJAIDInt64(tx.ID),
+		State:        string(tx.State),
+		Data:         hexutil.Bytes(tx.EncodedPayload),
+		From:         &tx.FromAddress,
+		To:           &tx.ToAddress,
+		Value:        v.String(),
+		GasLimit:     strconv.FormatUint(tx.FeeLimit, 10),
+		Reverted:     result.Reverted,
+		GasUsed:      strconv.FormatUint(result.GasUsed, 10),
+		ReturnData:   result.ReturnData,
+		Error:        result.Error,
+		RevertData:   result.RevertData,
+		RevertReason: result.RevertReason,
+	}
+
+	if tx.ChainID != nil {
+		r.EVMChainID = *big.New(tx.ChainID)
+	}
+	return r
+}
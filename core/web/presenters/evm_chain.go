@@ -24,6 +24,8 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	// Score is the selection score of the node, if the node selection mode relies on scores.
+	Score *float64 `json:"score,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -33,7 +35,7 @@ func (r EVMNodeResource) GetName() string {
 
 // NewEVMNodeResource returns a new EVMNodeResource for node.
 func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
-	return EVMNodeResource{NodeResource{
+	return EVMNodeResource{NodeResource: NodeResource{
 		JAID:    NewPrefixedJAID(node.Name, node.ChainID),
 		ChainID: node.ChainID,
 		Name:    node.Name,
@@ -516,6 +516,7 @@ type JobResource struct {
 	SchemaVersion            uint32                    `json:"schemaVersion"`
 	GasLimit                 clnull.Uint32             `json:"gasLimit"`
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
+	CaptureResponses         bool                      `json:"captureResponses"`
 	MaxTaskDuration          models.Interval           `json:"maxTaskDuration"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
@@ -547,6 +548,7 @@ func NewJobResource(j job.Job) *JobResource {
 		SchemaVersion:     j.SchemaVersion,
 		GasLimit:          j.GasLimit,
 		ForwardingAllowed: j.ForwardingAllowed,
+		CaptureResponses:  j.CaptureResponses,
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
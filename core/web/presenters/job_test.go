@@ -120,6 +120,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 1000,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -196,6 +197,7 @@ func TestJob(t *testing.T) {
 						},
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
 						"directRequestSpec": null,
@@ -287,6 +289,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": 123,
 						"forwardingAllowed": true,
+						"captureResponses": false,
 						"directRequestSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
@@ -351,6 +354,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
@@ -415,6 +419,7 @@ func TestJob(t *testing.T) {
                         "fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
                         "directRequestSpec": null,
                         "keeperSpec": null,
                         "offChainReportingOracleSpec": null,
@@ -475,6 +480,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"directRequestSpec": null,
 						"keeperSpec": null,
 						"cronSpec": null,
@@ -537,6 +543,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -624,6 +631,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -707,6 +715,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -780,6 +789,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -847,6 +857,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -912,6 +923,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -975,6 +987,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -1038,6 +1051,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"cronSpec": null,
 						"offChainReportingOracleSpec": null,
 						"offChainReporting2OracleSpec": null,
@@ -1125,6 +1139,7 @@ func TestJob(t *testing.T) {
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
 						"forwardingAllowed": false,
+						"captureResponses": false,
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
@@ -90,3 +90,30 @@ func TestNodeResource(t *testing.T) {
 		assert.JSONEq(t, expected, string(b))
 	}
 }
+
+func TestEVMNodeResource_Score(t *testing.T) {
+	r := NewEVMNodeResource(types.NodeStatus{ChainID: "1", Name: "primary", Config: "cfg", State: "Alive"})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "score")
+
+	score := 0.75
+	r.Score = &score
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `
+	{
+	  "data":{
+		  "type":"evm_node",
+		  "id":"1/primary",
+		  "attributes":{
+			 "chainID":"1",
+			 "name":"primary",
+			 "config":"cfg",
+			 "state":"Alive",
+			 "score":0.75
+		  }
+	  }
+	}`, string(b))
+}
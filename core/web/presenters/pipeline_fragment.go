@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResource represents a version of a pipeline fragment JSONAPI resource.
+type PipelineFragmentResource struct {
+	JAID
+	Name         string    `json:"name"`
+	Version      int32     `json:"version"`
+	DotDagSource string    `json:"dotDagSource"`
+	CreatedAt    time.Time `json:"createdAt"`
+	// JobIDs are the jobs which invoke the fragment. They are only provided
+	// when a fragment is changed or shown, since those jobs are affected by changes.
+	JobIDs []int32 `json:"jobIDs,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineFragmentResource) GetName() string {
+	return "pipelineFragments"
+}
+
+// NewPipelineFragmentResource constructs a new PipelineFragmentResource
+func NewPipelineFragmentResource(f pipeline.Fragment) *PipelineFragmentResource {
+	return &PipelineFragmentResource{
+		// Uses the name as the id, since jobs refer to fragments by name
+		JAID:         NewJAID(f.Name),
+		Name:         f.Name,
+		Version:      f.Version,
+		DotDagSource: f.DotDagSource,
+		CreatedAt:    f.CreatedAt,
+	}
+}
@@ -104,3 +104,86 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineSimulationResource represents the result of simulating the pipeline of a job spec
+type PipelineSimulationResource struct {
+	JAID
+	State       pipeline.RunStatus                  `json:"state"`
+	Outputs     []*string                           `json:"outputs"`
+	FatalErrors []*string                           `json:"fatalErrors"`
+	TaskRuns    []PipelineSimulationTaskRunResource `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulation"
+}
+
+// PipelineSimulationTaskRunResource is the result of a single task of a simulated run
+type PipelineSimulationTaskRunResource struct {
+	PipelineTaskRunResource
+	Duration string `json:"duration"`
+}
+
+func NewPipelineSimulationResource(pr pipeline.Run, lggr logger.Logger) PipelineSimulationResource {
+	lggr = lggr.Named("PipelineSimulationResource")
+	var trs []PipelineSimulationTaskRunResource
+	for i := range pr.PipelineTaskRuns {
+		tr := pr.PipelineTaskRuns[i]
+		trs = append(trs, PipelineSimulationTaskRunResource{
+			PipelineTaskRunResource: NewPipelineTaskRunResource(tr),
+			Duration:                tr.FinishedAt.ValueOrZero().Sub(tr.CreatedAt).String(),
+		})
+	}
+
+	outputs, err := pr.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", pr.Outputs)
+	}
+
+	return PipelineSimulationResource{
+		JAID:        NewJAID("simulation"),
+		State:       pr.State,
+		Outputs:     outputs,
+		FatalErrors: pr.StringFatalErrors(),
+		TaskRuns:    trs,
+	}
+}
+
+// PipelineReplayResource represents a stored pipeline run and its replay from the captured responses
+type PipelineReplayResource struct {
+	JAID
+	Original PipelineSimulationResource `json:"original"`
+	Replay   PipelineSimulationResource `json:"replay"`
+	// Diverged is true if the replay produced different outputs or errors than the original run
+	Diverged bool `json:"diverged"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineReplayResource) GetName() string {
+	return "pipelineReplay"
+}
+
+func NewPipelineReplayResource(original pipeline.Run, replay pipeline.Run, lggr logger.Logger) PipelineReplayResource {
+	lggr = lggr.Named("PipelineReplayResource")
+	o := NewPipelineSimulationResource(original, lggr)
+	r := NewPipelineSimulationResource(replay, lggr)
+	return PipelineReplayResource{
+		JAID:     NewJAIDInt64(original.ID),
+		Original: o,
+		Replay:   r,
+		Diverged: !equalStrings(o.Outputs, r.Outputs) || !equalStrings(o.FatalErrors, r.FatalErrors),
+	}
+}
+
+func equalStrings(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) || (a[i] != nil && *a[i] != *b[i]) {
+			return false
+		}
+	}
+	return true
+}
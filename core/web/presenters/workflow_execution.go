@@ -0,0 +1,103 @@
+package presenters
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents an execution of a workflow JSONAPI resource.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// WorkflowExecutionStepResource is a step of a workflow execution, with
+// its inputs and outputs as JSON.
+type WorkflowExecutionStepResource struct {
+	Ref      string  `json:"ref"`
+	Status   string  `json:"status"`
+	Attempts int     `json:"attempts"`
+	Inputs   *string `json:"inputs"`
+	Outputs  *string `json:"outputs"`
+	Error    *string `json:"error"`
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource,
+// with the steps ordered by ref.
+func NewWorkflowExecutionResource(we store.WorkflowExecution) WorkflowExecutionResource {
+	steps := []WorkflowExecutionStepResource{}
+	for _, step := range we.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(*step))
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].Ref < steps[j].Ref })
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+		Steps:      steps,
+	}
+}
+
+// NewWorkflowExecutionStepResource constructs a new WorkflowExecutionStepResource.
+func NewWorkflowExecutionStepResource(step store.WorkflowExecutionStep) WorkflowExecutionStepResource {
+	resource := WorkflowExecutionStepResource{
+		Ref:      step.Ref,
+		Status:   step.Status,
+		Attempts: step.Attempts,
+	}
+	if step.Inputs != nil {
+		resource.Inputs = valueJSON(step.Inputs)
+	}
+	if step.Outputs.Value != nil {
+		resource.Outputs = valueJSON(step.Outputs.Value)
+	}
+	if step.Outputs.Err != nil {
+		err := step.Outputs.Err.Error()
+		resource.Error = &err
+	}
+	return resource
+}
+
+// NewWorkflowExecutionResources constructs a slice of WorkflowExecutionResources.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, we := range wes {
+		rs = append(rs, NewWorkflowExecutionResource(we))
+	}
+	return rs
+}
+
+// valueJSON returns the JSON of the unwrapped value, or a description of
+// the failure as values are not guaranteed to be JSON compatible.
+func valueJSON(v values.Value) *string {
+	var s string
+	unwrapped, err := v.Unwrap()
+	if err == nil {
+		var b []byte
+		b, err = json.Marshal(unwrapped)
+		s = string(b)
+	}
+	if err != nil {
+		s = "<unable to encode value: " + err.Error() + ">"
+	}
+	return &s
+}
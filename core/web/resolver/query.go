@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -629,3 +630,60 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution by its execution id.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct{ ID graphql.ID }) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, workflowstore.ErrExecutionNotFound) {
+			return NewWorkflowExecutionPayload(execution, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(execution, nil), nil
+}
+
+// WorkflowExecutions retrieves a paginated list of workflow executions,
+// optionally filtered by workflow, status and creation time.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	Offset        *int32
+	Limit         *int32
+	WorkflowID    *string
+	Status        *string
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	var filter workflowstore.ExecutionFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Status != nil {
+		filter.Status = ToWorkflowExecutionStatus(WorkflowExecutionStatus(*args.Status))
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowORM().List(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
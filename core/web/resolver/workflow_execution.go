@@ -0,0 +1,169 @@
+package resolver
+
+import (
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type WorkflowExecutionStatus string
+
+// FromWorkflowExecutionStatus converts a store status to a GQL enum.
+func FromWorkflowExecutionStatus(status string) WorkflowExecutionStatus {
+	return WorkflowExecutionStatus(strings.ToUpper(status))
+}
+
+// ToWorkflowExecutionStatus converts a GQL enum to a store status.
+func ToWorkflowExecutionStatus(status WorkflowExecutionStatus) string {
+	return strings.ToLower(string(status))
+}
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	execution presenters.WorkflowExecutionResource
+}
+
+func NewWorkflowExecution(we store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: presenters.NewWorkflowExecutionResource(we)}
+}
+
+func NewWorkflowExecutions(wes []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+	for _, we := range wes {
+		resolvers = append(resolvers, NewWorkflowExecution(we))
+	}
+
+	return resolvers
+}
+
+// ID resolves the execution ID.
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ID)
+}
+
+// WorkflowID resolves the ID of the executed workflow.
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+// Status resolves the status of the execution.
+func (r *WorkflowExecutionResolver) Status() WorkflowExecutionStatus {
+	return FromWorkflowExecutionStatus(r.execution.Status)
+}
+
+// CreatedAt resolves the time the execution started.
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return gqlTime(r.execution.CreatedAt)
+}
+
+// UpdatedAt resolves the time the execution was last updated.
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return gqlTime(r.execution.UpdatedAt)
+}
+
+// FinishedAt resolves the time the execution finished.
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return gqlTime(r.execution.FinishedAt)
+}
+
+// Steps resolves the steps of the execution, ordered by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := []*WorkflowExecutionStepResolver{}
+	for _, step := range r.execution.Steps {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{step: step})
+	}
+
+	return resolvers
+}
+
+func gqlTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	step presenters.WorkflowExecutionStepResource
+}
+
+// Ref resolves the ref of the step.
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+// Status resolves the status of the step.
+func (r *WorkflowExecutionStepResolver) Status() WorkflowExecutionStatus {
+	return FromWorkflowExecutionStatus(r.step.Status)
+}
+
+// Attempts resolves the number of times the step has been executed.
+func (r *WorkflowExecutionStepResolver) Attempts() int32 {
+	return int32(r.step.Attempts)
+}
+
+// Inputs resolves the inputs of the step as JSON.
+func (r *WorkflowExecutionStepResolver) Inputs() *string {
+	return r.step.Inputs
+}
+
+// Outputs resolves the outputs of the step as JSON.
+func (r *WorkflowExecutionStepResolver) Outputs() *string {
+	return r.step.Outputs
+}
+
+// Error resolves the error of the step.
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	return r.step.Error
+}
+
+// -- WorkflowExecution Query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, store.ErrExecutionNotFound)
+	}}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+// ToWorkflowExecution implements the WorkflowExecutionPayload union type of the payload
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(r.execution), true
+}
+
+// -- WorkflowExecutions Query --
+
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
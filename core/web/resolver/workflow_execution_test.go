@@ -0,0 +1,168 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func Test_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions {
+			workflowExecutions(workflowID: "wf", status: ERRORED, createdAfter: "2021-01-01T00:00:00Z") {
+				results {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+					steps {
+						ref
+						status
+						attempts
+						inputs
+						outputs
+						error
+					}
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				createdAt := f.Timestamp()
+				inputs, err := values.NewMap(map[string]any{"price": 100})
+				if err != nil {
+					t.Fatal(err)
+				}
+				filter := store.ExecutionFilter{WorkflowID: "wf", Status: store.StatusErrored, CreatedAfter: f.Timestamp()}
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+				f.Mocks.workflowORM.On("List", mock.Anything, mock.MatchedBy(func(actual store.ExecutionFilter) bool {
+					return actual.WorkflowID == filter.WorkflowID && actual.Status == filter.Status && actual.CreatedAfter.Equal(filter.CreatedAfter) && actual.CreatedBefore.IsZero()
+				}), PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{
+						ExecutionID: "exec1",
+						WorkflowID:  "wf",
+						Status:      store.StatusErrored,
+						CreatedAt:   &createdAt,
+						Steps: map[string]*store.WorkflowExecutionStep{
+							"write":   {Ref: "write", Status: store.StatusErrored, Attempts: 3, Inputs: inputs, Outputs: store.StepOutput{Err: errors.New("boom")}},
+							"trigger": {Ref: "trigger", Status: store.StatusCompleted, Attempts: 1, Outputs: store.StepOutput{Value: values.NewString("ok")}},
+						},
+					},
+				}, 1, nil)
+			},
+			query: query,
+			result: `
+			{
+				"workflowExecutions": {
+					"results": [{
+						"id": "exec1",
+						"workflowID": "wf",
+						"status": "ERRORED",
+						"createdAt": "2021-01-01T00:00:00Z",
+						"finishedAt": null,
+						"steps": [{
+							"ref": "trigger",
+							"status": "COMPLETED",
+							"attempts": 1,
+							"inputs": null,
+							"outputs": "\"ok\"",
+							"error": null
+						}, {
+							"ref": "write",
+							"status": "ERRORED",
+							"attempts": 3,
+							"inputs": "{\"price\":100}",
+							"outputs": null,
+							"error": "boom"
+						}]
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"id": "exec1"}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+				f.Mocks.workflowORM.On("Get", mock.Anything, "exec1").Return(store.WorkflowExecution{
+					ExecutionID: "exec1",
+					Status:      store.StatusCompletedEarlyExit,
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"workflowExecution": {
+					"id": "exec1",
+					"status": "COMPLETED_EARLY_EXIT"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowORM").Return(f.Mocks.workflowORM)
+				f.Mocks.workflowORM.On("Get", mock.Anything, "exec1").Return(store.WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id exec1: %w", store.ErrExecutionNotFound))
+			},
+			query:     query,
+			variables: variables,
+			result: `
+			{
+				"workflowExecution": {
+					"message": "workflow execution not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
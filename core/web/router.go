@@ -267,6 +267,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
 		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
 
+		pfc := PipelineFragmentsController{app}
+		authv2.GET("/pipeline/fragments", paginatedRequest(pfc.Index))
+		authv2.POST("/pipeline/fragments", auth.RequiresEditRole(pfc.Create))
+		authv2.GET("/pipeline/fragments/:Name", pfc.Show)
+		authv2.DELETE("/pipeline/fragments/:Name", auth.RequiresEditRole(pfc.Destroy))
+
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
 		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
@@ -286,6 +292,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:ID/simulate", auth.RequiresRunRole(txs.Simulate))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
@@ -321,6 +328,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
 		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
 		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.POST("/keys/evm/resync", auth.RequiresAdminRole(ekc.Resync))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
@@ -370,6 +378,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -377,6 +386,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/pipeline/runs/:runID/replay", auth.RequiresRunRole(prc.Replay))
+
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflows/executions/:executionID", wec.Show)
+		authv2.POST("/workflows/executions/:executionID/cancel", auth.RequiresRunRole(wec.Cancel))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -0,0 +1,97 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController lists and shows the executions of workflows.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflow executions, most recent first. They can be filtered
+// by workflow ID, status and an RFC3339 creation time range.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored&createdAfter=2024-01-01T00:00:00Z"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := ParseWorkflowExecutionFilter(c.Query("workflowID"), c.Query("status"), c.Query("createdAfter"), c.Query("createdBefore"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().List(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "workflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Show returns a workflow execution with the inputs, outputs and errors of its steps.
+// Example:
+// "GET <application>/workflows/executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("executionID"))
+	if errors.Is(err, store.ErrExecutionNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecutions")
+}
+
+// Cancel cancels a running workflow execution, which stops dispatching its
+// remaining steps and interrupts the ones executing.
+// Example:
+// "POST <application>/workflows/executions/:executionID/cancel"
+func (wec *WorkflowExecutionsController) Cancel(c *gin.Context) {
+	execution, err := wec.App.WorkflowEngines().CancelExecution(c.Request.Context(), c.Param("executionID"))
+	switch {
+	case errors.Is(err, store.ErrExecutionNotFound):
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	case errors.Is(err, workflows.ErrExecutionNotRunning):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecutions")
+}
+
+// ParseWorkflowExecutionFilter returns the filter of the given query
+// parameters, of which the times must be formatted as RFC3339.
+func ParseWorkflowExecutionFilter(workflowID, status, createdAfter, createdBefore string) (filter store.ExecutionFilter, err error) {
+	filter.WorkflowID = workflowID
+	if status != "" && !store.ValidStatuses[status] {
+		return filter, errors.Errorf("invalid status %q", status)
+	}
+	filter.Status = status
+	if createdAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(time.RFC3339, createdAfter); err != nil {
+			return filter, errors.Wrap(err, "invalid createdAfter")
+		}
+	}
+	if createdBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(time.RFC3339, createdBefore); err != nil {
+			return filter, errors.Wrap(err, "invalid createdBefore")
+		}
+	}
+	return filter, nil
+}